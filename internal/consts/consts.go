@@ -172,6 +172,199 @@ const (
 	FieldClientToken              = "client_token"
 	FieldWrappedToken             = "wrapped_token"
 	FieldOrphan                   = "orphan"
+	FieldTitle                    = "title"
+	FieldMessage                  = "message"
+	FieldAuthenticated            = "authenticated"
+	FieldStartTime                = "start_time"
+	FieldEndTime                  = "end_time"
+	FieldLink                     = "link"
+	FieldHref                     = "href"
+	FieldOptions                  = "options"
+	FieldActive                   = "active"
+	FieldDefaultAuthType          = "default_auth_type"
+	FieldBackupAuthTypes          = "backup_auth_types"
+	FieldDisableInheritance       = "disable_inheritance"
+	FieldMounts                   = "mounts"
+	FieldPaths                    = "paths"
+	FieldAccessor                 = "accessor"
+	FieldAccessors                = "accessors"
+	FieldListingVisibility        = "listing_visibility"
+	FieldAllowedResponseHeaders   = "allowed_response_headers"
+	FieldAllowedManagedKeys       = "allowed_managed_keys"
+	FieldBackends                 = "backends"
+	FieldTokenType                = "token_type"
+	FieldUserLockoutConfig        = "user_lockout_config"
+	FieldLockoutThreshold         = "lockout_threshold"
+	FieldLockoutDuration          = "lockout_duration"
+	FieldLockoutDisable           = "lockout_disable"
+	FieldPluginVersion            = "plugin_version"
+	FieldIdentityTokenKey         = "identity_token_key"
+	FieldIdentityTokenAudience    = "identity_token_audience"
+	FieldIdentityTokenTTL         = "identity_token_ttl"
+	FieldRoleArn                  = "role_arn"
+	FieldDeletionProtection       = "deletion_protection"
+	FieldSHA256                   = "sha256"
+	FieldCommand                  = "command"
+	FieldArgs                     = "args"
+	FieldEnv                      = "env"
+	FieldOCIImage                 = "oci_image"
+	FieldRuntime                  = "runtime"
+	FieldBuiltin                  = "builtin"
+	FieldDeprecationStatus        = "deprecation_status"
+	FieldPlugins                  = "plugins"
+	FieldOCIRuntime               = "oci_runtime"
+	FieldCgroupParent             = "cgroup_parent"
+	FieldCPUNanos                 = "cpu_nanos"
+	FieldMemoryBytes              = "memory_bytes"
+	FieldRootless                 = "rootless"
+	FieldPlugin                   = "plugin"
+	FieldKeepers                  = "keepers"
+	FieldReloadID                 = "reload_id"
+	FieldLicenseID                = "license_id"
+	FieldCustomerID               = "customer_id"
+	FieldInstallationID           = "installation_id"
+	FieldIssueTime                = "issue_time"
+	FieldExpirationTime           = "expiration_time"
+	FieldTerminationTime          = "termination_time"
+	FieldProduct                  = "product"
+	FieldFeatures                 = "features"
+	FieldEnabled                  = "enabled"
+	FieldRetentionMonths          = "retention_months"
+	FieldReportingEnabled         = "reporting_enabled"
+	FieldFormat                   = "format"
+	FieldHealthy                  = "healthy"
+	FieldFailureTolerance         = "failure_tolerance"
+	FieldLeader                   = "leader"
+	FieldVoters                   = "voters"
+	FieldServers                  = "servers"
+	FieldNodeStatus               = "node_status"
+	FieldStatus                   = "status"
+	FieldLastContact              = "last_contact"
+	FieldLastTerm                 = "last_term"
+	FieldLastIndex                = "last_index"
+	FieldUpgradeStatus            = "upgrade_status"
+	FieldUpgradeTargetVersion     = "upgrade_target_version"
+	FieldOutputPath               = "output_path"
+	FieldSize                     = "size"
+	FieldMaxTTL                   = "max_ttl"
+	FieldApproved                 = "approved"
+	FieldRequestPath              = "request_path"
+	FieldRequestEntity            = "request_entity"
+	FieldAuthorizations           = "authorizations"
+	FieldEntityID                 = "entity_id"
+	FieldEntityName               = "entity_name"
+	FieldCustomMetadata           = "custom_metadata"
+	FieldForceDestroy             = "force_destroy"
+	FieldRecursive                = "recursive"
+	FieldNamespaces               = "namespaces"
+	FieldParent                   = "parent"
+	FieldSecondaryID              = "secondary_id"
+	FieldEncryptedToken           = "encrypted_token"
+	FieldMode                     = "mode"
+	FieldState                    = "state"
+	FieldClusterID                = "cluster_id"
+	FieldLastWAL                  = "last_wal"
+	FieldLastRemoteWAL            = "last_remote_wal"
+	FieldDR                       = "dr"
+	FieldPerformance              = "performance"
+	FieldGCP                      = "gcp"
+	FieldProject                  = "project"
+	FieldKeyRing                  = "key_ring"
+	FieldCryptoKey                = "crypto_key"
+	FieldMaxParallel              = "max_parallel"
+	FieldIsRunning                = "is_running"
+	FieldEntriesProcessed         = "entries_processed"
+	FieldEntriesSucceeded         = "entries_succeeded"
+	FieldEntriesFailed            = "entries_failed"
+	FieldTerm                     = "term"
+	FieldInstallTime              = "install_time"
+	FieldMaxOperations            = "max_operations"
+	FieldInterval                 = "interval"
+	FieldInitialized              = "initialized"
+	FieldSealed                   = "sealed"
+	FieldStandby                  = "standby"
+	FieldClusterName              = "cluster_name"
+	FieldServerTimeUTC            = "server_time_utc"
+	FieldThreshold                = "threshold"
+	FieldShares                   = "shares"
+	FieldProgress                 = "progress"
+	FieldMigration                = "migration"
+	FieldRecoverySeal             = "recovery_seal"
+	FieldStorageType              = "storage_type"
+	FieldHAEnabled                = "ha_enabled"
+	FieldIsSelf                   = "is_self"
+	FieldActiveTime               = "active_time"
+	FieldLeaderAddress            = "leader_address"
+	FieldPatch                    = "patch"
+	FieldMaxDepth                 = "max_depth"
+	FieldMatch                    = "match"
+	FieldVersions                 = "versions"
+	FieldAction                   = "action"
+	FieldPrefix                   = "prefix"
+	FieldSecrets                  = "secrets"
+	FieldDataBool                 = "data_bool"
+	FieldDataNumber               = "data_number"
+	FieldIssuerRef                = "issuer_ref"
+	FieldIssuerName               = "issuer_name"
+	FieldIssuerID                 = "issuer_id"
+	FieldUsage                    = "usage"
+	FieldManualChain              = "manual_chain"
+	FieldOCSPServers              = "ocsp_servers"
+	FieldCertificate              = "certificate"
+	FieldCAChain                  = "ca_chain"
+	FieldPEMBundle                = "pem_bundle"
+	FieldPrivateKey               = "private_key"
+	FieldDefault                  = "default"
+	FieldPasswordPolicy           = "password_policy"
+
+	FieldPassthroughRequestHeaders   = "passthrough_request_headers"
+	FieldLockoutCounterResetDuration = "lockout_counter_reset_duration"
+	FieldDelegatedAuthAccessors      = "delegated_auth_accessors"
+	FieldPerformanceStandbyCount     = "performance_standby_count"
+	FieldIncludeUtilizationReport    = "include_utilization_report"
+	FieldUtilizationReportJSON       = "utilization_report_json"
+	FieldBillingStartTimestamp       = "billing_start_timestamp"
+	FieldOptimisticFailureTolerance  = "optimistic_failure_tolerance"
+	FieldSecondaryPublicKey          = "secondary_public_key"
+	FieldPrimaryClusterAddr          = "primary_cluster_addr"
+	FieldKnownSecondaries            = "known_secondaries"
+	FieldPerformanceStandby          = "performance_standby"
+	FieldReplicationPerformanceMode  = "replication_performance_mode"
+	FieldReplicationDRMode           = "replication_dr_mode"
+	FieldLeaderClusterAddress        = "leader_cluster_address"
+	FieldReadMetadataOnly            = "read_metadata_only"
+	FieldLeafNotAfterBehavior        = "leaf_not_after_behavior"
+	FieldIssuingCertificates         = "issuing_certificates"
+	FieldCRLDistributionPoints       = "crl_distribution_points"
+	FieldEnableAIAURLTemplating      = "enable_aia_url_templating"
+	FieldDefaultFollowsLatestIssuer  = "default_follows_latest_issuer"
+
+	FieldRevocationSignatureAlgorithm = "revocation_signature_algorithm"
+
+	FieldBindDN                       = "binddn"
+	FieldBindPass                     = "bindpass"
+	FieldURL                          = "url"
+	FieldUserDN                       = "userdn"
+	FieldUserAttr                     = "userattr"
+	FieldUPNDomain                    = "upndomain"
+	FieldStartTLS                     = "starttls"
+	FieldInsecureTLS                  = "insecure_tls"
+	FieldClientTLSCert                = "client_tls_cert"
+	FieldClientTLSKey                 = "client_tls_key"
+	FieldConnectionTimeout            = "connection_timeout"
+	FieldRequestTimeout               = "request_timeout"
+	FieldSchema                       = "schema"
+	FieldSkipStaticRoleImportRotation = "skip_static_role_import_rotation"
+	FieldDN                           = "dn"
+	FieldRotationPeriod               = "rotation_period"
+	FieldSkipImportRotation           = "skip_import_rotation"
+	FieldCreationLDIF                 = "creation_ldif"
+	FieldDeletionLDIF                 = "deletion_ldif"
+	FieldRollbackLDIF                 = "rollback_ldif"
+	FieldUsernameTemplate             = "username_template"
+	FieldDefaultTTL                   = "default_ttl"
+	FieldServiceAccountNames          = "service_account_names"
+	FieldDisableCheckInEnforcement    = "disable_check_in_enforcement"
 
 	/*
 		common environment variables
@@ -208,27 +401,38 @@ const (
 	/*
 		common mount types
 	*/
-	MountTypeDatabase   = "database"
-	MountTypePKI        = "pki"
-	MountTypeAWS        = "aws"
-	MountTypeKMIP       = "kmip"
-	MountTypeRabbitMQ   = "rabbitmq"
-	MountTypeNomad      = "nomad"
-	MountTypeKubernetes = "kubernetes"
-	MountTypeUserpass   = "userpass"
-	MountTypeCert       = "cert"
-	MountTypeGCP        = "gcp"
-	MountTypeKerberos   = "kerberos"
-	MountTypeRadius     = "radius"
-	MountTypeOCI        = "oci"
-	MountTypeOIDC       = "oidc"
-	MountTypeJWT        = "jwt"
-	MountTypeAzure      = "azure"
-	MountTypeGitHub     = "github"
+	MountTypeDatabase     = "database"
+	MountTypePKI          = "pki"
+	MountTypeAWS          = "aws"
+	MountTypeKMIP         = "kmip"
+	MountTypeRabbitMQ     = "rabbitmq"
+	MountTypeNomad        = "nomad"
+	MountTypeKubernetes   = "kubernetes"
+	MountTypeUserpass     = "userpass"
+	MountTypeCert         = "cert"
+	MountTypeGCP          = "gcp"
+	MountTypeKerberos     = "kerberos"
+	MountTypeRadius       = "radius"
+	MountTypeOCI          = "oci"
+	MountTypeOIDC         = "oidc"
+	MountTypeJWT          = "jwt"
+	MountTypeAzure        = "azure"
+	MountTypeGitHub       = "github"
+	MountTypeMongoDBAtlas = "mongodbatlas"
+	MountTypeLDAP         = "ldap"
+	MountTypeAliCloud     = "alicloud"
 
 	/*
 		Vault version constants
 	*/
+	VaultVersion120 = "1.20.0"
+	VaultVersion118 = "1.18.0"
+	VaultVersion117 = "1.17.0"
+	VaultVersion116 = "1.16.0"
+	VaultVersion115 = "1.15.0"
+	VaultVersion114 = "1.14.0"
+	VaultVersion113 = "1.13.0"
+	VaultVersion112 = "1.12.0"
 	VaultVersion111 = "1.11.0"
 	VaultVersion110 = "1.10.0"
 	VaultVersion190 = "1.9.0"
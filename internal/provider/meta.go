@@ -30,12 +30,28 @@ var (
 	VaultVersion190 *version.Version
 	VaultVersion110 *version.Version
 	VaultVersion111 *version.Version
+	VaultVersion112 *version.Version
+	VaultVersion113 *version.Version
+	VaultVersion114 *version.Version
+	VaultVersion115 *version.Version
+	VaultVersion116 *version.Version
+	VaultVersion117 *version.Version
+	VaultVersion118 *version.Version
+	VaultVersion120 *version.Version
 )
 
 func init() {
 	VaultVersion190 = version.Must(version.NewSemver(consts.VaultVersion190))
 	VaultVersion110 = version.Must(version.NewSemver(consts.VaultVersion110))
 	VaultVersion111 = version.Must(version.NewSemver(consts.VaultVersion111))
+	VaultVersion112 = version.Must(version.NewSemver(consts.VaultVersion112))
+	VaultVersion113 = version.Must(version.NewSemver(consts.VaultVersion113))
+	VaultVersion114 = version.Must(version.NewSemver(consts.VaultVersion114))
+	VaultVersion115 = version.Must(version.NewSemver(consts.VaultVersion115))
+	VaultVersion116 = version.Must(version.NewSemver(consts.VaultVersion116))
+	VaultVersion117 = version.Must(version.NewSemver(consts.VaultVersion117))
+	VaultVersion118 = version.Must(version.NewSemver(consts.VaultVersion118))
+	VaultVersion120 = version.Must(version.NewSemver(consts.VaultVersion120))
 }
 
 // ProviderMeta provides resources with access to the Vault client and
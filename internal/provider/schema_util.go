@@ -50,6 +50,20 @@ func MustAddMountMigrationSchema(r *schema.Resource) *schema.Resource {
 	return r
 }
 
+func MustAddDeletionProtectionSchema(r *schema.Resource) *schema.Resource {
+	MustAddSchema(r, map[string]*schema.Schema{
+		consts.FieldDeletionProtection: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "If set, the resource cannot be destroyed " +
+				"until this is unset and applied.",
+		},
+	})
+
+	return r
+}
+
 func GetNamespaceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.FieldNamespace: {
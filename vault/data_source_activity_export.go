@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const activityExportPath = "sys/internal/counters/activity/export"
+
+func activityExportDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(activityExportDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldStartTime: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Start of the date range to export, in RFC3339 format.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			consts.FieldEndTime: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "End of the date range to export, in RFC3339 format.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			consts.FieldFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				Description:  "Format of the export, one of 'json' or 'csv'.",
+				ValidateFunc: validation.StringInSlice([]string{"json", "csv"}, false),
+			},
+			consts.FieldData: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The exported client activity records.",
+			},
+		},
+	}
+}
+
+func activityExportDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	startTime := d.Get(consts.FieldStartTime).(string)
+	endTime := d.Get(consts.FieldEndTime).(string)
+	format := d.Get(consts.FieldFormat).(string)
+
+	start, _ := time.Parse(time.RFC3339, startTime)
+	end, _ := time.Parse(time.RFC3339, endTime)
+	if !end.After(start) {
+		return diag.Errorf("%s must be after %s", consts.FieldEndTime, consts.FieldStartTime)
+	}
+
+	// the export is streamed in the requested format rather than being
+	// wrapped in a secret, so it cannot be read with the logical client.
+	r := client.NewRequest("GET", "/v1/"+activityExportPath)
+	r.Params.Set(consts.FieldStartTime, startTime)
+	r.Params.Set(consts.FieldEndTime, endTime)
+	r.Params.Set(consts.FieldFormat, format)
+
+	log.Printf("[DEBUG] Exporting client activity from %s to %s", startTime, endTime)
+	resp, err := client.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return diag.Errorf("error exporting client activity: %s", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.Errorf("error reading client activity export: %s", err)
+	}
+
+	if err := d.Set(consts.FieldData, string(body)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s?start_time=%s&end_time=%s&format=%s",
+		activityExportPath, startTime, endTime, format))
+
+	return nil
+}
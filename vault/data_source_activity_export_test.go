@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceActivityExport(t *testing.T) {
+	dataName := "data.vault_activity_export.test"
+
+	end := time.Now().UTC().Truncate(time.Second)
+	start := end.AddDate(0, -1, 0)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.14") {
+				t.Skip("activity export requires Vault 1.14 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceActivityExportConfig(end, start),
+				ExpectError: regexp.MustCompile("end_time must be after start_time"),
+			},
+			{
+				Config: testDataSourceActivityExportConfig(start, end),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldStartTime, start.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(dataName, consts.FieldEndTime, end.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(dataName, consts.FieldFormat, "csv"),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldData),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceActivityExportConfig(start, end time.Time) string {
+	return fmt.Sprintf(`
+resource "vault_activity_config" "test" {
+  enabled = "enable"
+}
+
+data "vault_activity_export" "test" {
+  start_time = "%s"
+  end_time   = "%s"
+  format     = "csv"
+
+  depends_on = [vault_activity_config.test]
+}
+`, start.Format(time.RFC3339), end.Format(time.RFC3339))
+}
@@ -0,0 +1,275 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	fieldStaticRoles = "static_roles"
+	fieldLibrarySets = "library_sets"
+)
+
+// adSecretBackendLDAPConfigFields are the AD secrets engine config fields
+// that have an equivalent, identically named, LDAP secrets engine config field.
+var adSecretBackendLDAPConfigFields = []string{
+	consts.FieldBindDN,
+	consts.FieldURL,
+	consts.FieldPasswordPolicy,
+	consts.FieldUserDN,
+	consts.FieldUserAttr,
+	consts.FieldUPNDomain,
+	consts.FieldStartTLS,
+	consts.FieldInsecureTLS,
+	consts.FieldCertificate,
+	consts.FieldClientTLSCert,
+	consts.FieldRequestTimeout,
+}
+
+func adSecretBackendLDAPConfigDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(adSecretBackendLDAPConfigRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Description: "The mount path of the AD secrets engine to migrate.",
+				Required:    true,
+			},
+			consts.FieldBindDN: {
+				Type:        schema.TypeString,
+				Description: "Distinguished name of the object to bind as when managing passwords.",
+				Computed:    true,
+			},
+			consts.FieldURL: {
+				Type:        schema.TypeString,
+				Description: "The LDAP server to connect to.",
+				Computed:    true,
+			},
+			consts.FieldPasswordPolicy: {
+				Type:        schema.TypeString,
+				Description: "Name of the password policy to use to generate passwords.",
+				Computed:    true,
+			},
+			consts.FieldSchema: {
+				Type:        schema.TypeString,
+				Description: "The LDAP schema to use when storing entry passwords, always ad.",
+				Computed:    true,
+			},
+			consts.FieldUserDN: {
+				Type:        schema.TypeString,
+				Description: "The base DN under which to perform user search.",
+				Computed:    true,
+			},
+			consts.FieldUserAttr: {
+				Type:        schema.TypeString,
+				Description: "The attribute field name used to perform user search.",
+				Computed:    true,
+			},
+			consts.FieldUPNDomain: {
+				Type:        schema.TypeString,
+				Description: "The userPrincipalDomain used to construct the UPN string for the authenticating user.",
+				Computed:    true,
+			},
+			consts.FieldStartTLS: {
+				Type:        schema.TypeBool,
+				Description: "Issue a StartTLS command after establishing an unencrypted connection.",
+				Computed:    true,
+			},
+			consts.FieldInsecureTLS: {
+				Type:        schema.TypeBool,
+				Description: "Skip LDAP server SSL certificate verification.",
+				Computed:    true,
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Description: "CA certificate to use when verifying the LDAP server certificate.",
+				Computed:    true,
+			},
+			consts.FieldClientTLSCert: {
+				Type:        schema.TypeString,
+				Description: "Client certificate to provide to the LDAP server.",
+				Computed:    true,
+			},
+			consts.FieldRequestTimeout: {
+				Type:        schema.TypeInt,
+				Description: "Timeout, in seconds, for the connection when making requests against the server.",
+				Computed:    true,
+			},
+			fieldStaticRoles: {
+				Type:        schema.TypeList,
+				Description: "The AD roles mapped to LDAP static roles.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldRoleName: {
+							Type:        schema.TypeString,
+							Description: "The name of the role.",
+							Computed:    true,
+						},
+						consts.FieldUsername: {
+							Type:        schema.TypeString,
+							Description: "The service account the role manages the password of.",
+							Computed:    true,
+						},
+						consts.FieldRotationPeriod: {
+							Type:        schema.TypeInt,
+							Description: "How often the password is rotated, in seconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			fieldLibrarySets: {
+				Type:        schema.TypeList,
+				Description: "The AD libraries mapped to LDAP library sets.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Description: "The name of the set of service accounts.",
+							Computed:    true,
+						},
+						consts.FieldServiceAccountNames: {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the service accounts that can be checked out from the set.",
+							Computed:    true,
+						},
+						consts.FieldTTL: {
+							Type:        schema.TypeInt,
+							Description: "The amount of time, in seconds, a single check-out lasts.",
+							Computed:    true,
+						},
+						consts.FieldMaxTTL: {
+							Type:        schema.TypeInt,
+							Description: "The maximum amount of time, in seconds, a check-out lasts with renewal.",
+							Computed:    true,
+						},
+						consts.FieldDisableCheckInEnforcement: {
+							Type:        schema.TypeBool,
+							Description: "Disable enforcing that service accounts must be checked in by the entity or client token that checked them out.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func adSecretBackendLDAPConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	configPath := fmt.Sprintf("%s/config", backend)
+
+	log.Printf("[DEBUG] Reading AD secrets backend config %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return diag.Errorf("error reading AD secrets backend config %q: %s", configPath, err)
+	}
+	if resp == nil {
+		return diag.Errorf("no AD secrets backend config found at %q", configPath)
+	}
+
+	d.SetId(configPath)
+
+	for _, k := range adSecretBackendLDAPConfigFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("error setting state key %q, err=%s", k, err)
+			}
+		}
+	}
+	if err := d.Set(consts.FieldSchema, "ad"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleNames, err := adSecretBackendLDAPConfigList(client, backend+"/roles")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var staticRoles []map[string]interface{}
+	for _, name := range roleNames {
+		path := fmt.Sprintf("%s/roles/%s", backend, name)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return diag.Errorf("error reading AD role %q: %s", path, err)
+		}
+		if resp == nil {
+			continue
+		}
+
+		staticRoles = append(staticRoles, map[string]interface{}{
+			consts.FieldRoleName:       name,
+			consts.FieldUsername:       resp.Data["service_account_name"],
+			consts.FieldRotationPeriod: resp.Data[consts.FieldTTL],
+		})
+	}
+	if err := d.Set(fieldStaticRoles, staticRoles); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s", fieldStaticRoles, err)
+	}
+
+	setNames, err := adSecretBackendLDAPConfigList(client, backend+"/library")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var librarySets []map[string]interface{}
+	for _, name := range setNames {
+		path := fmt.Sprintf("%s/library/%s", backend, name)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return diag.Errorf("error reading AD library %q: %s", path, err)
+		}
+		if resp == nil {
+			continue
+		}
+
+		set := map[string]interface{}{
+			consts.FieldName: name,
+		}
+		for _, k := range ldapSecretBackendLibrarySetFields {
+			set[k] = resp.Data[k]
+		}
+		librarySets = append(librarySets, set)
+	}
+	if err := d.Set(fieldLibrarySets, librarySets); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s", fieldLibrarySets, err)
+	}
+
+	return nil
+}
+
+func adSecretBackendLDAPConfigList(client *api.Client, path string) ([]string, error) {
+	log.Printf("[DEBUG] Listing %q", path)
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %q: %s", path, err)
+	}
+
+	var names []string
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, k := range keys {
+				names = append(names, k.(string))
+			}
+		}
+	}
+
+	return names, nil
+}
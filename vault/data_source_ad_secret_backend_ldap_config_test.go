@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceADSecretBackendLDAPConfig(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ad")
+	bindDN, bindPass, url := testutil.GetTestADCreds(t)
+
+	dataSourceName := "data.vault_ad_secret_backend_ldap_config.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceADSecretBackendLDAPConfig(backend, bindDN, bindPass, url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldBindDN, bindDN),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldURL, url),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldSchema, "ad"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldInsecureTLS, "true"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldUserDN, "CN=Users,DC=corp,DC=example,DC=net"),
+					resource.TestCheckResourceAttr(dataSourceName, "static_roles.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "static_roles.0.role_name", "bob"),
+					resource.TestCheckResourceAttr(dataSourceName, "static_roles.0.username", "Bob"),
+					resource.TestCheckResourceAttr(dataSourceName, "static_roles.0.rotation_period", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "library_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "library_sets.0.name", "qa"),
+					resource.TestCheckResourceAttr(dataSourceName, "library_sets.0.service_account_names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "library_sets.0.service_account_names.0", "Mary"),
+					resource.TestCheckResourceAttr(dataSourceName, "library_sets.0.ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "library_sets.0.max_ttl", "120"),
+					resource.TestCheckResourceAttr(dataSourceName, "library_sets.0.disable_check_in_enforcement", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceADSecretBackendLDAPConfig(backend, bindDN, bindPass, url string) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "config" {
+  backend      = "%s"
+  binddn       = "%s"
+  bindpass     = "%s"
+  url          = "%s"
+  insecure_tls = "true"
+  userdn       = "CN=Users,DC=corp,DC=example,DC=net"
+}
+
+resource "vault_ad_secret_role" "role" {
+  backend              = vault_ad_secret_backend.config.backend
+  role                 = "bob"
+  service_account_name = "Bob"
+  ttl                  = 60
+}
+
+resource "vault_ad_secret_library" "library" {
+  backend               = vault_ad_secret_backend.config.backend
+  name                  = "qa"
+  service_account_names = ["Mary"]
+  ttl                   = 60
+  max_ttl               = 120
+}
+
+data "vault_ad_secret_backend_ldap_config" "test" {
+  backend = vault_ad_secret_backend.config.backend
+
+  depends_on = [
+    vault_ad_secret_role.role,
+    vault_ad_secret_library.library,
+  ]
+}
+`, backend, bindDN, bindPass, url)
+}
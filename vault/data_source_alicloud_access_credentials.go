@@ -0,0 +1,118 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	fieldAliCloudSecurityToken = "security_token"
+	fieldAliCloudExpiration    = "expiration"
+)
+
+func alicloudAccessCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(readAliCloudAccessCredentials),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Description: "The AliCloud secret backend to generate credentials from.",
+				Required:    true,
+			},
+			consts.FieldRole: {
+				Type:        schema.TypeString,
+				Description: "The name of the role.",
+				Required:    true,
+			},
+			consts.FieldAccessKey: {
+				Type:        schema.TypeString,
+				Description: "The AliCloud access key ID.",
+				Computed:    true,
+			},
+			consts.FieldSecretKey: {
+				Type:        schema.TypeString,
+				Description: "The AliCloud access key secret.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			fieldAliCloudSecurityToken: {
+				Type:        schema.TypeString,
+				Description: "The STS security token, only set for roles using a role_arn.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			fieldAliCloudExpiration: {
+				Type:        schema.TypeString,
+				Description: "The expiration time of the STS credentials, only set for roles using a role_arn.",
+				Computed:    true,
+			},
+			consts.FieldLeaseID: {
+				Type:        schema.TypeString,
+				Description: "The lease identifier assigned by Vault.",
+				Computed:    true,
+			},
+			consts.FieldLeaseDuration: {
+				Type:        schema.TypeInt,
+				Description: "The duration of the lease in seconds.",
+				Computed:    true,
+			},
+			consts.FieldLeaseRenewable: {
+				Type:        schema.TypeBool,
+				Description: "True if the duration of this lease can be extended through renewal.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func readAliCloudAccessCredentials(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	role := d.Get(consts.FieldRole).(string)
+	path := fmt.Sprintf("%s/creds/%s", backend, role)
+	secret, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading from Vault: %s", err)
+	}
+	if secret == nil {
+		return diag.Errorf("no role found at %q", path)
+	}
+
+	d.SetId(secret.LeaseID)
+	dataFields := []string{
+		consts.FieldAccessKey,
+		consts.FieldSecretKey,
+		fieldAliCloudSecurityToken,
+		fieldAliCloudExpiration,
+	}
+	for _, k := range dataFields {
+		if err := d.Set(k, secret.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q, err=%s", k, err)
+		}
+	}
+
+	if err := d.Set(consts.FieldLeaseID, secret.LeaseID); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s",
+			consts.FieldLeaseID, err)
+	}
+	if err := d.Set(consts.FieldLeaseDuration, secret.LeaseDuration); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s",
+			consts.FieldLeaseDuration, err)
+	}
+	if err := d.Set(consts.FieldLeaseRenewable, secret.Renewable); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s",
+			consts.FieldLeaseRenewable, err)
+	}
+
+	return nil
+}
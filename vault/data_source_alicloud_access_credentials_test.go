@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceAliCloudAccessCredentials(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "ALICLOUD_ACCESS_KEY", "ALICLOUD_SECRET_KEY")
+	accessKey, secretKey := values[0], values[1]
+
+	backend := acctest.RandomWithPrefix("tf-test-alicloud")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	dataSourceName := "data.vault_alicloud_access_credentials.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceAliCloudAccessCredentialsConfig(backend, accessKey, secretKey, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secret_key"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_token", ""),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "lease_duration", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceAliCloudAccessCredentialsConfig(backend, accessKey, secretKey, name string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path       = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_alicloud_secret_backend_role" "test" {
+  backend         = vault_alicloud_secret_backend.test.path
+  name            = "%s"
+  remote_policies = ["name:AliyunOSSReadOnlyAccess,type:System"]
+  ttl             = 3600
+}
+
+data "vault_alicloud_access_credentials" "test" {
+  backend = vault_alicloud_secret_backend.test.path
+  role    = vault_alicloud_secret_backend_role.test.name
+}
+`, backend, accessKey, secretKey, name)
+}
@@ -0,0 +1,198 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func authBackendsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(authBackendsDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return auth backends of this type, e.g. 'kubernetes'.",
+			},
+			consts.FieldPaths: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The paths of all matching auth backends.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldAccessors: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The accessors of all matching auth backends, in the same order as paths.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldBackends: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The details of all matching auth backends, ordered by path.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldPath: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The auth backend mount point.",
+						},
+						consts.FieldType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the auth backend.",
+						},
+						consts.FieldDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the auth backend.",
+						},
+						consts.FieldAccessor: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The accessor of the auth backend.",
+						},
+						consts.FieldLocal: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the auth backend is local only.",
+						},
+						consts.FieldSealWrap: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether seal wrapping is enabled for the auth backend.",
+						},
+						consts.FieldOptions: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The auth backend type specific options.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldDefaultLeaseTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Default lease duration in seconds.",
+						},
+						consts.FieldMaxLeaseTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum possible lease duration in seconds.",
+						},
+						consts.FieldListingVisibility: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the auth backend is shown in the UI-specific listing endpoint.",
+						},
+						consts.FieldTokenType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of token issued by the auth backend.",
+						},
+						consts.FieldAuditNonHMACRequestKeys: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Keys that are not HMAC'd by audit devices in the request data object.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldAuditNonHMACResponseKeys: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Keys that are not HMAC'd by audit devices in the response data object.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldPassthroughRequestHeaders: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Request headers that are passed through to the auth backend.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldAllowedResponseHeaders: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Response headers that the auth backend is allowed to set.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authBackendsDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Listing auth backends from Vault")
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return diag.Errorf("error reading auth backends from Vault: %s", err)
+	}
+
+	authType := d.Get(consts.FieldType).(string)
+
+	var paths []string
+	for path, auth := range auths {
+		if authType != "" && auth.Type != authType {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var backends []map[string]interface{}
+	var resultPaths, accessors []string
+	for _, path := range paths {
+		auth := auths[path]
+		path = strings.TrimSuffix(path, consts.PathDelim)
+		resultPaths = append(resultPaths, path)
+		accessors = append(accessors, auth.Accessor)
+		backends = append(backends, map[string]interface{}{
+			consts.FieldPath:                      path,
+			consts.FieldType:                      auth.Type,
+			consts.FieldDescription:               auth.Description,
+			consts.FieldAccessor:                  auth.Accessor,
+			consts.FieldLocal:                     auth.Local,
+			consts.FieldSealWrap:                  auth.SealWrap,
+			consts.FieldOptions:                   auth.Options,
+			consts.FieldDefaultLeaseTTL:           auth.Config.DefaultLeaseTTL,
+			consts.FieldMaxLeaseTTL:               auth.Config.MaxLeaseTTL,
+			consts.FieldListingVisibility:         auth.Config.ListingVisibility,
+			consts.FieldTokenType:                 auth.Config.TokenType,
+			consts.FieldAuditNonHMACRequestKeys:   auth.Config.AuditNonHMACRequestKeys,
+			consts.FieldAuditNonHMACResponseKeys:  auth.Config.AuditNonHMACResponseKeys,
+			consts.FieldPassthroughRequestHeaders: auth.Config.PassthroughRequestHeaders,
+			consts.FieldAllowedResponseHeaders:    auth.Config.AllowedResponseHeaders,
+		})
+	}
+
+	if err := d.Set(consts.FieldPaths, resultPaths); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldAccessors, accessors); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldBackends, backends); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := "sys/auth"
+	if authType != "" {
+		id += "?type=" + authType
+	}
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceAuthBackends(t *testing.T) {
+	userpassPath := acctest.RandomWithPrefix("tf-test-userpass")
+	approlePath := acctest.RandomWithPrefix("tf-test-approle")
+	dataName := "data.vault_auth_backends.test"
+	dataNameFiltered := "data.vault_auth_backends.filtered"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceAuthBackendsConfig(userpassPath, approlePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataName, "paths.*", "token"),
+					resource.TestCheckTypeSetElemAttr(dataName, "paths.*", userpassPath),
+					resource.TestCheckTypeSetElemAttr(dataName, "paths.*", approlePath),
+					resource.TestCheckTypeSetElemAttrPair(dataName, "accessors.*",
+						"vault_auth_backend.userpass", "accessor"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataName, "backends.*", map[string]string{
+						consts.FieldPath:              userpassPath,
+						consts.FieldType:              "userpass",
+						consts.FieldDescription:       "test userpass backend",
+						consts.FieldListingVisibility: "unauth",
+					}),
+					resource.TestCheckTypeSetElemAttr(dataNameFiltered, "paths.*", approlePath),
+					resource.TestCheckTypeSetElemAttrPair(dataNameFiltered, "backends.*.accessor",
+						"vault_auth_backend.approle", "accessor"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceAuthBackendsConfig(userpassPath, approlePath string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  path        = "%s"
+  type        = "userpass"
+  description = "test userpass backend"
+  tune {
+    listing_visibility = "unauth"
+  }
+}
+
+resource "vault_auth_backend" "approle" {
+  path = "%s"
+  type = "approle"
+}
+
+data "vault_auth_backends" "test" {
+  depends_on = [vault_auth_backend.userpass, vault_auth_backend.approle]
+}
+
+data "vault_auth_backends" "filtered" {
+  type       = "approle"
+  depends_on = [vault_auth_backend.userpass, vault_auth_backend.approle]
+}
+`, userpassPath, approlePath)
+}
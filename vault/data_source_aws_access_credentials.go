@@ -1,13 +1,17 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -38,6 +42,14 @@ const (
 	propagationBuffer = 5 * time.Second
 )
 
+// awsConsoleDomains maps the AWS partitions supporting federated console
+// sign-in to the domain of their sign-in and console hosts.
+var awsConsoleDomains = map[string]string{
+	endpoints.AwsPartitionID:      "aws.amazon.com",
+	endpoints.AwsUsGovPartitionID: "amazonaws-us-gov.com",
+	endpoints.AwsCnPartitionID:    "amazonaws.cn",
+}
+
 func awsAccessCredentialsDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: ReadWrapper(awsAccessCredentialsDataSourceRead),
@@ -122,6 +134,17 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 				Optional:    true,
 				Description: "User specified Time-To-Live for the STS token. Uses the Role defined default_sts_ttl when not specified",
 			},
+			"generate_console_url": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, generate a federated AWS console sign-in URL for the STS credentials. Only valid if type is 'sts'.",
+			},
+			"console_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Federated AWS console sign-in URL for the STS credentials. (Only returned if generate_console_url is true).",
+			},
 		},
 	}
 }
@@ -137,6 +160,22 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	role := d.Get("role").(string)
 	path := backend + "/" + credType + "/" + role
 
+	region := d.Get("region").(string)
+
+	generateConsoleURL := d.Get("generate_console_url").(bool)
+	var consoleDomain string
+	if generateConsoleURL {
+		if credType != "sts" {
+			return fmt.Errorf("generate_console_url is only valid when type is sts")
+		}
+
+		var err error
+		consoleDomain, err = awsConsoleDomain(region)
+		if err != nil {
+			return err
+		}
+	}
+
 	arn := d.Get("role_arn").(string)
 	// If the ARN is empty and only one is specified in the role definition, this should work without issue
 	data := map[string][]string{
@@ -179,7 +218,6 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 		HTTPClient:  cleanhttp.DefaultClient(),
 	}
 
-	region := d.Get("region").(string)
 	if region != "" {
 		awsConfig.Region = &region
 	}
@@ -200,6 +238,15 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 		if _, err := stsconn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
 			return err
 		}
+
+		if generateConsoleURL {
+			log.Printf("[DEBUG] Generating AWS console sign-in URL for %q", secret.LeaseID)
+			consoleURL, err := awsConsoleSignInURL(consoleDomain, accessKey, secretKey, securityToken)
+			if err != nil {
+				return err
+			}
+			d.Set("console_url", consoleURL)
+		}
 		return nil
 	}
 
@@ -240,6 +287,71 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// awsConsoleDomain returns the domain of the sign-in and console hosts of the
+// region's partition, the commercial partition is used if region is empty.
+func awsConsoleDomain(region string) (string, error) {
+	partitionID := endpoints.AwsPartitionID
+	if region != "" {
+		p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+		if !ok {
+			return "", fmt.Errorf("unable to determine the AWS partition of region %q", region)
+		}
+		partitionID = p.ID()
+	}
+
+	domain, ok := awsConsoleDomains[partitionID]
+	if !ok {
+		return "", fmt.Errorf("generate_console_url is not supported in the %q AWS partition", partitionID)
+	}
+
+	return domain, nil
+}
+
+// awsConsoleSignInURL exchanges the STS credentials for a sign-in token with the
+// AWS federation endpoint of the given domain, and returns the console sign-in
+// URL using that token.
+func awsConsoleSignInURL(domain, accessKey, secretKey, securityToken string) (string, error) {
+	federationEndpoint := fmt.Sprintf("https://signin.%s/federation", domain)
+
+	sessionJSON, err := json.Marshal(map[string]string{
+		"sessionId":    accessKey,
+		"sessionKey":   secretKey,
+		"sessionToken": securityToken,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"Action":  {"getSigninToken"},
+		"Session": {string(sessionJSON)},
+	}
+	resp, err := cleanhttp.DefaultClient().Get(federationEndpoint + "?" + params.Encode())
+	if err != nil {
+		return "", fmt.Errorf("error requesting AWS sign-in token: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error requesting AWS sign-in token: unexpected status %s", resp.Status)
+	}
+
+	var body struct {
+		SigninToken string `json:"SigninToken"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("error decoding AWS sign-in token: %s", err)
+	}
+
+	params = url.Values{
+		"Action":      {"login"},
+		"Issuer":      {"terraform-provider-vault"},
+		"Destination": {fmt.Sprintf("https://console.%s/", domain)},
+		"SigninToken": {body.SigninToken},
+	}
+	return federationEndpoint + "?" + params.Encode(), nil
+}
+
 func isAWSAuthError(err error) bool {
 	awsErr, ok := err.(awserr.Error)
 	if !ok {
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -164,6 +165,28 @@ func TestAccDataSourceAWSAccessCredentials_sts_ttl(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAWSAccessCredentials_sts_consoleURL(t *testing.T) {
+	mountPath := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := testutil.GetTestAWSCreds(t)
+	region := testutil.GetTestAWSRegion(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSAccessCredentialsConfig_sts_consoleURL(mountPath, accessKey, secretKey, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_aws_access_credentials.test", "type", "sts"),
+					resource.TestCheckResourceAttr("data.vault_aws_access_credentials.test", "generate_console_url", "true"),
+					resource.TestMatchResourceAttr("data.vault_aws_access_credentials.test", "console_url",
+						regexp.MustCompile(`^https://signin\.aws\.amazon\.com/federation\?.*Action=login.*SigninToken=.+`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAWSAccessCredentialsConfig_basic(mountPath, accessKey, secretKey, region string) string {
 	return fmt.Sprintf(`
 resource "vault_aws_secret_backend" "aws" {
@@ -240,6 +263,32 @@ data "vault_aws_access_credentials" "test" {
 }`, mountPath, accessKey, secretKey, region, ttl)
 }
 
+func testAccDataSourceAWSAccessCredentialsConfig_sts_consoleURL(mountPath, accessKey, secretKey, region string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "aws" {
+	path = "%s"
+	description = "Obtain AWS credentials."
+	access_key = "%s"
+	secret_key = "%s"
+	region = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "role" {
+	backend = vault_aws_secret_backend.aws.path
+	name = "test"
+	credential_type = "federation_token"
+	policy_document = "{\"Version\": \"2012-10-17\", \"Statement\": [{\"Effect\": \"Allow\", \"Action\": \"iam:*\", \"Resource\": \"*\"}]}"
+}
+
+data "vault_aws_access_credentials" "test" {
+	backend = vault_aws_secret_backend.aws.path
+	role = vault_aws_secret_backend_role.role.name
+	type = "sts"
+	region = vault_aws_secret_backend.aws.region
+	generate_console_url = true
+}`, mountPath, accessKey, secretKey, region)
+}
+
 func testAccDataSourceAWSAccessCredentialsCheck_tokenWorks(region string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["data.vault_aws_access_credentials.test"]
@@ -288,3 +337,48 @@ func testAccDataSourceAWSAccessCredentialsCheck_tokenWorks(region string) resour
 		return nil
 	}
 }
+
+func TestAWSConsoleDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		region  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "default",
+			want: "aws.amazon.com",
+		},
+		{
+			name:   "commercial",
+			region: "eu-west-1",
+			want:   "aws.amazon.com",
+		},
+		{
+			name:   "govcloud",
+			region: "us-gov-west-1",
+			want:   "amazonaws-us-gov.com",
+		},
+		{
+			name:   "china",
+			region: "cn-north-1",
+			want:   "amazonaws.cn",
+		},
+		{
+			name:    "unsupported-partition",
+			region:  "us-iso-east-1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := awsConsoleDomain(tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("awsConsoleDomain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("awsConsoleDomain() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func awsStaticAccessCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(awsStaticAccessCredentialsDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS Secret Backend to read credentials from.",
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS static role to read credentials from.",
+			},
+			consts.FieldAccessKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "AWS access key ID read from Vault.",
+				Sensitive:   true,
+			},
+			consts.FieldSecretKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "AWS secret key read from Vault.",
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func awsStaticAccessCredentialsDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion114) {
+		return diag.Errorf("AWS static roles require Vault %s or later", provider.VaultVersion114)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	name := d.Get(consts.FieldName).(string)
+	path := backend + "/static-creds/" + name
+
+	log.Printf("[DEBUG] Reading AWS static credentials %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading AWS static credentials %q: %s", path, err)
+	}
+	if secret == nil {
+		return diag.Errorf("no AWS static credentials found at %q", path)
+	}
+	log.Printf("[DEBUG] Read AWS static credentials %q", path)
+
+	d.SetId(path)
+
+	for _, k := range []string{consts.FieldAccessKey, consts.FieldSecretKey} {
+		if err := d.Set(k, secret.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceAWSStaticAccessCredentials(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := testutil.GetTestAWSCreds(t)
+	username := testutil.SkipTestEnvUnset(t, "AWS_STATIC_USERNAME")[0]
+	dataName := "data.vault_aws_static_access_credentials.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.14") {
+				t.Skip("AWS static roles require Vault 1.14 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSStaticAccessCredentialsConfig(backend, name, accessKey, secretKey, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(dataName, consts.FieldName, name),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldAccessKey),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldSecretKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSStaticAccessCredentialsConfig(backend, name, accessKey, secretKey, username string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path       = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_static_role" "test" {
+  backend         = vault_aws_secret_backend.test.path
+  name            = "%s"
+  username        = "%s"
+  rotation_period = 3600
+}
+
+data "vault_aws_static_access_credentials" "test" {
+  backend = vault_aws_secret_backend.test.path
+  name    = vault_aws_secret_backend_static_role.test.name
+}
+`, backend, accessKey, secretKey, name, username)
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const controlGroupRequestPath = "sys/control-group/request"
+
+func controlGroupRequestDataSource() *schema.Resource {
+	entitySchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					consts.FieldEntityID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the entity.",
+					},
+					consts.FieldEntityName: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the entity.",
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(controlGroupRequestDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldAccessor: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The accessor of the control group wrapping token.",
+			},
+			consts.FieldApproved: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the request has been approved by all required authorizers.",
+			},
+			consts.FieldRequestPath: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path of the request that is pending authorization.",
+			},
+			consts.FieldRequestEntity:  entitySchema("The entity that made the request."),
+			consts.FieldAuthorizations: entitySchema("The entities that have authorized the request."),
+		},
+	}
+}
+
+func controlGroupRequestDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	accessor := d.Get(consts.FieldAccessor).(string)
+
+	log.Printf("[DEBUG] Checking control group request %q", accessor)
+	resp, err := client.Logical().Write(controlGroupRequestPath, map[string]interface{}{
+		consts.FieldAccessor: accessor,
+	})
+	if err != nil {
+		return diag.Errorf("error checking control group request %q: %s", accessor, err)
+	}
+
+	if resp == nil {
+		return diag.Errorf("no control group request found for accessor %q", accessor)
+	}
+
+	if err := d.Set(consts.FieldApproved, resp.Data[consts.FieldApproved]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldRequestPath, resp.Data[consts.FieldRequestPath]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var requestEntity []interface{}
+	if v, ok := resp.Data[consts.FieldRequestEntity].(map[string]interface{}); ok {
+		requestEntity = append(requestEntity, flattenControlGroupEntity(v))
+	}
+	if err := d.Set(consts.FieldRequestEntity, requestEntity); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var authorizations []interface{}
+	if v, ok := resp.Data[consts.FieldAuthorizations].([]interface{}); ok {
+		for _, raw := range v {
+			if entity, ok := raw.(map[string]interface{}); ok {
+				authorizations = append(authorizations, flattenControlGroupEntity(entity))
+			}
+		}
+	}
+	if err := d.Set(consts.FieldAuthorizations, authorizations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(accessor)
+
+	return nil
+}
+
+// flattenControlGroupEntity maps an entity of the control group request
+// response, which uses id and name keys.
+func flattenControlGroupEntity(entity map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		consts.FieldEntityID:   entity["id"],
+		consts.FieldEntityName: entity["name"],
+	}
+}
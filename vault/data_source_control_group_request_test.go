@@ -0,0 +1,27 @@
+package vault
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceControlGroupRequest_unknownAccessor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_control_group_request" "test" {
+  accessor = "unknown-accessor"
+}
+`,
+				ExpectError: regexp.MustCompile(`error checking control group request "unknown-accessor"`),
+			},
+		},
+	})
+}
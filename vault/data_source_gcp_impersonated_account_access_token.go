@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func gcpImpersonatedAccountAccessTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(gcpImpersonatedAccountAccessTokenDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the GCP secrets engine is mounted.",
+			},
+			"impersonated_account": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Impersonated Account to generate an access token for.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "OAuth2 access token generated by Vault.",
+			},
+			"token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lifetime of the access token, in seconds.",
+			},
+			"expires_at_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Expiry of the access token, in seconds since the Unix epoch.",
+			},
+		},
+	}
+}
+
+func gcpImpersonatedAccountAccessTokenDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion113) {
+		return diag.Errorf("GCP impersonated accounts require Vault %s or later", provider.VaultVersion113)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := gcpSecretImpersonatedAccountPath(d.Get("backend").(string), d.Get("impersonated_account").(string)) + "/token"
+
+	log.Printf("[DEBUG] Reading GCP impersonated account access token %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading GCP impersonated account access token %q: %s", path, err)
+	}
+	if secret == nil {
+		return diag.Errorf("no GCP impersonated account found at %q", path)
+	}
+	log.Printf("[DEBUG] Read GCP impersonated account access token %q", path)
+
+	d.SetId(path)
+
+	if err := d.Set("token", secret.Data["token"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range []string{"token_ttl", "expires_at_seconds"} {
+		v, ok := secret.Data[k].(json.Number)
+		if !ok {
+			continue
+		}
+		n, err := v.Int64()
+		if err != nil {
+			return diag.Errorf("unexpected value %q for %s of %q: %s", v, k, path, err)
+		}
+		if err := d.Set(k, n); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
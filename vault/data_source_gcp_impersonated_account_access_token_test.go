@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"golang.org/x/oauth2/google"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceGCPImpersonatedAccountAccessToken(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	impersonatedAccount := acctest.RandomWithPrefix("tf-test")
+	credentials, _ := testutil.GetTestGCPCreds(t)
+
+	conf, err := google.JWTConfigFromJSON([]byte(credentials), "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		t.Fatalf("error decoding GCP Credentials: %v", err)
+	}
+
+	dataName := "data.vault_gcp_impersonated_account_access_token.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.13") {
+				t.Skip("GCP impersonated accounts require Vault 1.13 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceGCPImpersonatedAccountAccessTokenConfig(backend, impersonatedAccount, credentials, conf.Email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "backend", backend),
+					resource.TestCheckResourceAttr(dataName, "impersonated_account", impersonatedAccount),
+					resource.TestCheckResourceAttrSet(dataName, "token"),
+					resource.TestCheckResourceAttrSet(dataName, "token_ttl"),
+					resource.TestCheckResourceAttrSet(dataName, "expires_at_seconds"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceGCPImpersonatedAccountAccessTokenConfig(backend, impersonatedAccount, credentials, serviceAccountEmail string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_impersonated_account" "test" {
+  backend               = vault_gcp_secret_backend.test.path
+  impersonated_account  = "%s"
+  service_account_email = "%s"
+  token_scopes          = ["https://www.googleapis.com/auth/cloud-platform"]
+}
+
+data "vault_gcp_impersonated_account_access_token" "test" {
+  backend              = vault_gcp_secret_impersonated_account.test.backend
+  impersonated_account = vault_gcp_secret_impersonated_account.test.impersonated_account
+}
+`, backend, credentials, impersonatedAccount, serviceAccountEmail)
+}
@@ -52,6 +52,22 @@ func genericSecretDataSource() *schema.Resource {
 				Sensitive:   true,
 			},
 
+			consts.FieldDataBool: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the top-level boolean values read from Vault.",
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+
+			consts.FieldDataNumber: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the top-level numeric values read from Vault.",
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+
 			consts.FieldLeaseID: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -127,6 +143,10 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if err := setTypedDataMaps(d, secret.Data); err != nil {
+		return err
+	}
+
 	if err := d.Set(consts.FieldLeaseID, secret.LeaseID); err != nil {
 		return err
 	}
@@ -148,3 +168,30 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 	}
 	return nil
 }
+
+// setTypedDataMaps sets the top-level boolean and numeric values of data,
+// preserving their types. Nested values are only available from data_json.
+func setTypedDataMaps(d *schema.ResourceData, data map[string]interface{}) error {
+	bools := map[string]bool{}
+	numbers := map[string]float64{}
+	for k, v := range data {
+		switch v := v.(type) {
+		case bool:
+			bools[k] = v
+		case json.Number:
+			f, err := v.Float64()
+			if err != nil {
+				return fmt.Errorf("error parsing number %q for key %q: %s", v, k, err)
+			}
+			numbers[k] = f
+		case float64:
+			numbers[k] = v
+		}
+	}
+
+	if err := d.Set(consts.FieldDataBool, bools); err != nil {
+		return err
+	}
+
+	return d.Set(consts.FieldDataNumber, numbers)
+}
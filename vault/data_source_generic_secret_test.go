@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
 
@@ -48,6 +49,31 @@ func TestDataSourceGenericSecret_v2(t *testing.T) {
 	})
 }
 
+func TestDataSourceGenericSecret_typedData(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-acctest-kv")
+	path := acctest.RandomWithPrefix("foo")
+
+	resourceName := "data.vault_generic_secret.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceGenericSecretTypedData_config(mount, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataBool+".%", "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataBool+".enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataBool+".debug", "false"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataNumber+".%", "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataNumber+".port", "8200"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataNumber+".ratio", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "data.port", "8200"),
+				),
+			},
+		},
+	})
+}
+
 func testDataSourceV2Secret_config(mount, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -154,6 +180,38 @@ data "vault_generic_secret" "test" {
 
 `
 
+func testDataSourceGenericSecretTypedData_config(mount, path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "1"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+  path = "${vault_mount.test.path}/%s"
+  data_json = jsonencode(
+    {
+      host    = "vault.example.com",
+      port    = 8200,
+      ratio   = 0.5,
+      enabled = true,
+      debug   = false,
+      nested = {
+        retries = 3
+      }
+    }
+  )
+}
+
+data "vault_generic_secret" "test" {
+  path = vault_generic_secret.test.path
+}
+`, mount, path)
+}
+
 func testDataSourceGenericSecret_check(s *terraform.State) error {
 	resourceState := s.Modules[0].Resources["data.vault_generic_secret.test"]
 	if resourceState == nil {
@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func healthDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(healthDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldInitialized: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault is initialized.",
+			},
+			consts.FieldSealed: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault is sealed.",
+			},
+			consts.FieldStandby: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is a standby.",
+			},
+			consts.FieldPerformanceStandby: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is a performance standby.",
+			},
+			consts.FieldReplicationPerformanceMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The performance replication mode of the cluster.",
+			},
+			consts.FieldReplicationDRMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disaster recovery replication mode of the cluster.",
+			},
+			consts.FieldVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Vault version of the node.",
+			},
+			consts.FieldClusterName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the cluster.",
+			},
+			consts.FieldClusterID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the cluster.",
+			},
+			consts.FieldServerTimeUTC: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The server time of the node, in seconds since the Unix epoch.",
+			},
+		},
+	}
+}
+
+func healthDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading health status")
+	resp, err := client.Sys().Health()
+	if err != nil {
+		return diag.Errorf("error reading health status: %s", err)
+	}
+
+	fields := map[string]interface{}{
+		consts.FieldInitialized:                resp.Initialized,
+		consts.FieldSealed:                     resp.Sealed,
+		consts.FieldStandby:                    resp.Standby,
+		consts.FieldPerformanceStandby:         resp.PerformanceStandby,
+		consts.FieldReplicationPerformanceMode: resp.ReplicationPerformanceMode,
+		consts.FieldReplicationDRMode:          resp.ReplicationDRMode,
+		consts.FieldVersion:                    resp.Version,
+		consts.FieldClusterName:                resp.ClusterName,
+		consts.FieldClusterID:                  resp.ClusterID,
+		consts.FieldServerTimeUTC:              resp.ServerTimeUTC,
+	}
+
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q on health status, err=%s", k, err)
+		}
+	}
+
+	d.SetId("sys/health")
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceHealth(t *testing.T) {
+	dataName := "data.vault_health.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_health" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldInitialized, "true"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldSealed, "false"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldStandby, "false"),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldVersion),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldClusterID),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldServerTimeUTC),
+				),
+			},
+		},
+	})
+}
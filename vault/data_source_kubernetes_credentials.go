@@ -15,6 +15,7 @@ const (
 	fieldClusterRoleBinding      = "cluster_role_binding"
 	fieldServiceAccountNamespace = "service_account_namespace"
 	fieldServiceAccountToken     = "service_account_token"
+	fieldAudiences               = "audiences"
 )
 
 func kubernetesServiceAccountTokenDataSource() *schema.Resource {
@@ -51,6 +52,15 @@ func kubernetesServiceAccountTokenDataSource() *schema.Resource {
 					"specified in seconds or as a Go duration format string",
 				Optional: true,
 			},
+			fieldAudiences: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The intended audiences of the generated Kubernetes service account " +
+					"token. Defaults to the token_default_audiences of the role. Requires Vault 1.16 or later.",
+				Optional: true,
+			},
 			fieldServiceAccountName: {
 				Type:        schema.TypeString,
 				Description: "The name of the service account associated with the token.",
@@ -102,6 +112,13 @@ func readKubernetesServiceAccountToken(ctx context.Context, d *schema.ResourceDa
 		data[k] = d.Get(k)
 	}
 
+	if v, ok := d.GetOk(fieldAudiences); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+			return diag.Errorf("%q requires Vault %s or later", fieldAudiences, provider.VaultVersion116)
+		}
+		data[fieldAudiences] = v
+	}
+
 	backend := d.Get("backend").(string)
 	role := d.Get("role").(string)
 	path := fmt.Sprintf("%s/creds/%s", backend, role)
@@ -59,6 +59,22 @@ func kvSecretV2DataSource() *schema.Resource {
 				Sensitive:   true,
 			},
 
+			consts.FieldDataBool: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the top-level boolean values read from Vault.",
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+
+			consts.FieldDataNumber: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the top-level numeric values read from Vault.",
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+
 			"created_time": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -132,6 +148,10 @@ func kvSecretV2DataSourceRead(_ context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
+	if err := setTypedDataMaps(d, data.(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if v, ok := secret.Data["metadata"]; ok {
 		metadata := v.(map[string]interface{})
 
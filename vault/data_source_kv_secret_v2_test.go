@@ -67,3 +67,56 @@ data "vault_kv_secret_v2" "test" {
   name  = vault_kv_secret_v2.test.name
 }`, kvV2MountConfig(mount), name)
 }
+
+func TestDataSourceKVV2Secret_typedData(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+
+	resourceName := "data.vault_kv_secret_v2.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVV2SecretTypedDataConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataBool+".%", "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataBool+".enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataBool+".debug", "false"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataNumber+".%", "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataNumber+".port", "8200"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataNumber+".ratio", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "data.port", "8200"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVV2SecretTypedDataConfig(mount, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount               = vault_mount.kvv2.path
+  name                = "%s"
+  delete_all_versions = true
+  data_json = jsonencode(
+    {
+      host    = "vault.example.com",
+      port    = 8200,
+      ratio   = 0.5,
+      enabled = true,
+      debug   = false,
+      nested = {
+        retries = 3
+      }
+    }
+  )
+}
+
+data "vault_kv_secret_v2" "test" {
+  mount = vault_mount.kvv2.path
+  name  = vault_kv_secret_v2.test.name
+}`, kvV2MountConfig(mount), name)
+}
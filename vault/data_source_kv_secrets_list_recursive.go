@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func kvSecretListRecursiveDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(kvSecretListRecursiveDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the KV-V1 or KV-V2 engine is mounted.",
+			},
+
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The folder to list the secrets under, relative to the mount. " +
+					"Lists the whole mount if not set.",
+				ValidateFunc: provider.ValidateNoTrailingSlash,
+			},
+
+			consts.FieldMaxDepth: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The number of folder levels to descend into, " +
+					"1 only lists the secrets directly under name. Unlimited if not set.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			consts.FieldMatch: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "A glob pattern the secret names must match, '*' does not " +
+					"match across '/'.",
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := path.Match(i.(string), ""); err != nil {
+						return nil, []error{fmt.Errorf("invalid pattern for %q: %s", k, err)}
+					}
+					return nil, nil
+				},
+			},
+
+			consts.FieldNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted list of the names of all secrets found, " +
+					"relative to the mount.",
+			},
+		},
+	}
+}
+
+func kvSecretListRecursiveDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := strings.Trim(d.Get(consts.FieldMount).(string), "/")
+	name := d.Get(consts.FieldName).(string)
+	maxDepth := d.Get(consts.FieldMaxDepth).(int)
+	match := d.Get(consts.FieldMatch).(string)
+
+	_, v2, err := isKVv2(mount, client)
+	if err != nil {
+		return diag.Errorf("error determining the KV version of %q: %s", mount, err)
+	}
+
+	listPrefix := mount
+	if v2 {
+		listPrefix = getKVV2Path(mount, "", consts.FieldMetadata)
+	}
+
+	names, err := kvListRecursive(client, listPrefix, name, maxDepth, match)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldNames, names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Trim(mount+"/"+name, "/"))
+
+	return nil
+}
+
+// kvListRecursive lists the secrets under name, relative to listPrefix, down
+// to maxDepth folder levels, 0 means unlimited. Only the names matching the
+// glob pattern match are returned, if set.
+func kvListRecursive(client *api.Client, listPrefix, name string, maxDepth int, match string) ([]string, error) {
+	type folder struct {
+		name  string
+		depth int
+	}
+
+	var names []string
+	folders := []folder{{name: name, depth: 1}}
+	for len(folders) > 0 {
+		f := folders[0]
+		folders = folders[1:]
+
+		p := strings.TrimSuffix(listPrefix, "/")
+		if f.name != "" {
+			p = p + "/" + f.name
+		}
+
+		log.Printf("[DEBUG] Listing secrets at %s from Vault", p)
+		resp, err := client.Logical().List(p)
+		if err != nil {
+			return nil, fmt.Errorf("error listing from Vault at path %q, err=%s", p, err)
+		}
+
+		if resp == nil {
+			continue
+		}
+
+		keys, _ := resp.Data["keys"].([]interface{})
+		for _, k := range keys {
+			key := k.(string)
+			full := key
+			if f.name != "" {
+				full = f.name + "/" + key
+			}
+
+			if strings.HasSuffix(key, "/") {
+				if maxDepth == 0 || f.depth < maxDepth {
+					folders = append(folders, folder{
+						name:  strings.TrimSuffix(full, "/"),
+						depth: f.depth + 1,
+					})
+				}
+				continue
+			}
+
+			if match != "" {
+				if ok, _ := path.Match(match, full); !ok {
+					continue
+				}
+			}
+
+			names = append(names, full)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVSecretListRecursive(t *testing.T) {
+	mountV1 := acctest.RandomWithPrefix("tf-kv")
+	mountV2 := acctest.RandomWithPrefix("tf-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretListRecursiveConfig(mountV1, mountV2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.v1", "names.#", "3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.v1", "names.0", "app/api"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.v1", "names.1", "app/db/primary"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.v1", "names.2", "root"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.v2", "names.#", "3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.v2", "names.1", "app/db/primary"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.depth", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.depth", "names.0", "app/api"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.match", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_recursive.match", "names.0", "app/db/primary"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretListRecursiveConfig(mountV1, mountV2 string) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+locals {
+  secrets = ["root", "app/api", "app/db/primary"]
+}
+
+resource "vault_kv_secret" "test" {
+  for_each  = toset(local.secrets)
+  path      = "${vault_mount.kvv1.path}/${each.key}"
+  data_json = jsonencode({ foo = "bar" })
+}
+
+resource "vault_kv_secret_v2" "test" {
+  for_each  = toset(local.secrets)
+  mount     = vault_mount.kvv2.path
+  name      = each.key
+  data_json = jsonencode({ foo = "bar" })
+}
+
+data "vault_kv_secrets_list_recursive" "v1" {
+  mount      = vault_mount.kvv1.path
+  depends_on = [vault_kv_secret.test]
+}
+
+data "vault_kv_secrets_list_recursive" "v2" {
+  mount      = vault_mount.kvv2.path
+  depends_on = [vault_kv_secret_v2.test]
+}
+
+data "vault_kv_secrets_list_recursive" "depth" {
+  mount      = vault_mount.kvv2.path
+  name       = "app"
+  max_depth  = 1
+  depends_on = [vault_kv_secret_v2.test]
+}
+
+data "vault_kv_secrets_list_recursive" "match" {
+  mount      = vault_mount.kvv2.path
+  match      = "app/db/*"
+  depends_on = [vault_kv_secret_v2.test]
+}
+`, kvV1MountConfig(mountV1), kvV2MountConfig(mountV2))
+}
@@ -0,0 +1,187 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func kvSecretsV2DataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(kvSecretsV2DataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted",
+			},
+
+			consts.FieldNames: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Full names of the secrets to read. For a nested secret, " +
+					"the name is the nested path excluding the mount and data prefix.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{consts.FieldNames, consts.FieldPrefix},
+			},
+
+			consts.FieldPrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Read all secrets under this folder, recursively.",
+				ValidateFunc: provider.ValidateNoTrailingSlash,
+				ExactlyOneOf: []string{consts.FieldNames, consts.FieldPrefix},
+			},
+
+			consts.FieldMaxParallel: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "The maximum number of secrets read concurrently.",
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			consts.FieldSecrets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secrets read from Vault, ordered by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full name of the secret.",
+						},
+						consts.FieldVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Version of the secret.",
+						},
+						consts.FieldDataJSON: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON-encoded secret data read from Vault.",
+						},
+						consts.FieldData: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Map of strings read from Vault.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kvSecretsV2DataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := strings.Trim(d.Get(consts.FieldMount).(string), "/")
+
+	var names []string
+	if v, ok := d.GetOk(consts.FieldNames); ok {
+		for _, name := range v.([]interface{}) {
+			names = append(names, name.(string))
+		}
+	} else {
+		prefix := d.Get(consts.FieldPrefix).(string)
+		listPrefix := getKVV2Path(mount, "", consts.FieldMetadata)
+
+		var err error
+		names, err = kvListRecursive(client, listPrefix, prefix, 0, "")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	secrets := make([]map[string]interface{}, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, d.Get(consts.FieldMaxParallel).(int))
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			secrets[i], errs[i] = kvReadSecretV2(client, mount, name)
+		}(i, name)
+	}
+	wg.Wait()
+
+	var diags diag.Diagnostics
+	for _, err := range errs {
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i][consts.FieldName].(string) < secrets[j][consts.FieldName].(string)
+	})
+
+	if err := d.Set(consts.FieldSecrets, secrets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getKVV2Path(mount, d.Get(consts.FieldPrefix).(string), consts.FieldData))
+
+	return nil
+}
+
+// kvReadSecretV2 reads the latest version of a single secret for
+// the vault_kv_secrets_v2 data source.
+func kvReadSecretV2(client *api.Client, mount, name string) (map[string]interface{}, error) {
+	path := getKVV2Path(mount, name, consts.FieldData)
+
+	log.Printf("[DEBUG] Reading secret at %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret %q from Vault: %s", path, err)
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("no secret found at %q", path)
+	}
+
+	data, _ := secret.Data["data"].(map[string]interface{})
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+	}
+
+	result := map[string]interface{}{
+		consts.FieldName:     name,
+		consts.FieldDataJSON: string(jsonData),
+		consts.FieldData:     serializeDataMapToString(data),
+	}
+
+	if metadata, ok := secret.Data["metadata"].(map[string]interface{}); ok {
+		result[consts.FieldVersion] = metadata["version"]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVSecretsV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	dataNames := "data.vault_kv_secrets_v2.names"
+	dataPrefix := "data.vault_kv_secrets_v2.prefix"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretsV2Config(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataNames, "secrets.#", "2"),
+					resource.TestCheckResourceAttr(dataNames, "secrets.0.name", "app/api"),
+					resource.TestCheckResourceAttr(dataNames, "secrets.0.data.secret", "app/api"),
+					resource.TestCheckResourceAttr(dataNames, "secrets.0.version", "1"),
+					resource.TestCheckResourceAttr(dataNames, "secrets.1.name", "root"),
+					resource.TestCheckResourceAttr(dataPrefix, "secrets.#", "3"),
+					resource.TestCheckResourceAttr(dataPrefix, "secrets.1.name", "app/db/primary"),
+					resource.TestCheckResourceAttr(dataPrefix, "secrets.1.data_json", `{"secret":"app/db/primary"}`),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretsV2Config(mount string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  for_each  = toset(["root", "app/api", "app/db/primary", "app/db/replica"])
+  mount     = vault_mount.kvv2.path
+  name      = each.key
+  data_json = jsonencode({ secret = each.key })
+}
+
+data "vault_kv_secrets_v2" "names" {
+  mount        = vault_mount.kvv2.path
+  names        = ["root", "app/api"]
+  max_parallel = 1
+  depends_on   = [vault_kv_secret_v2.test]
+}
+
+data "vault_kv_secrets_v2" "prefix" {
+  mount      = vault_mount.kvv2.path
+  prefix     = "app"
+  depends_on = [vault_kv_secret_v2.test]
+}
+`, kvV2MountConfig(mount))
+}
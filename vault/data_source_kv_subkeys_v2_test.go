@@ -34,6 +34,22 @@ func TestDataSourceKVSubkeys(t *testing.T) {
 					testutil.CheckJSONData(resourceName, consts.FieldDataJSON, expectedSubkeys),
 				),
 			},
+			{
+				Config: testDataSourceKVSubkeysConfig(mount, secretPath) + `
+
+data "vault_kv_secret_subkeys_v2" "depth" {
+  mount   = vault_mount.kvv2.path
+  name    = vault_kv_secret_v2.test.name
+  version = 1
+  depth   = 1
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kv_secret_subkeys_v2.depth", "data.%", "3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_subkeys_v2.depth", "data.baz", "null"),
+					testutil.CheckJSONData("data.vault_kv_secret_subkeys_v2.depth", consts.FieldDataJSON,
+						`{"baz":null,"foo":null,"zip":null}`),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func leaderDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(leaderDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldHAEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether high availability is enabled.",
+			},
+			consts.FieldIsSelf: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node the provider is connected to is the leader.",
+			},
+			consts.FieldActiveTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the leader became active, in RFC3339 format.",
+			},
+			consts.FieldLeaderAddress: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API address of the leader.",
+			},
+			consts.FieldLeaderClusterAddress: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cluster address of the leader.",
+			},
+			consts.FieldPerformanceStandby: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node the provider is connected to is a performance standby.",
+			},
+		},
+	}
+}
+
+func leaderDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading HA leader")
+	resp, err := client.Sys().Leader()
+	if err != nil {
+		return diag.Errorf("error reading HA leader: %s", err)
+	}
+
+	var activeTime string
+	if !resp.ActiveTime.IsZero() {
+		activeTime = resp.ActiveTime.Format(time.RFC3339)
+	}
+
+	fields := map[string]interface{}{
+		consts.FieldHAEnabled:            resp.HAEnabled,
+		consts.FieldIsSelf:               resp.IsSelf,
+		consts.FieldActiveTime:           activeTime,
+		consts.FieldLeaderAddress:        resp.LeaderAddress,
+		consts.FieldLeaderClusterAddress: resp.LeaderClusterAddress,
+		consts.FieldPerformanceStandby:   resp.PerfStandby,
+	}
+
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q on HA leader, err=%s", k, err)
+		}
+	}
+
+	d.SetId("sys/leader")
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceLeader(t *testing.T) {
+	dataName := "data.vault_leader.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_leader" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldHAEnabled),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldIsSelf),
+					resource.TestCheckResourceAttr(dataName, consts.FieldPerformanceStandby, "false"),
+				),
+			},
+		},
+	})
+}
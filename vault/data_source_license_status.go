@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	licenseStatusPath     = "sys/license/status"
+	utilizationReportPath = "sys/utilization-report"
+)
+
+var licenseStatusFields = []string{
+	consts.FieldLicenseID,
+	consts.FieldCustomerID,
+	consts.FieldInstallationID,
+	consts.FieldIssueTime,
+	consts.FieldStartTime,
+	consts.FieldExpirationTime,
+	consts.FieldTerminationTime,
+	consts.FieldProduct,
+}
+
+func licenseStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(licenseStatusDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldIncludeUtilizationReport: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Also read the license utilization report. Requires Vault 1.17+.",
+			},
+			consts.FieldLicenseID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the license.",
+			},
+			consts.FieldCustomerID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the customer the license was issued to.",
+			},
+			consts.FieldInstallationID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The installation ID of the license, '*' if it is not bound to an installation.",
+			},
+			consts.FieldIssueTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the license was issued, in RFC3339 format.",
+			},
+			consts.FieldStartTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the license became valid, in RFC3339 format.",
+			},
+			consts.FieldExpirationTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the license expires, in RFC3339 format.",
+			},
+			consts.FieldTerminationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The time after which Vault stops working with the license, " +
+					"in RFC3339 format.",
+			},
+			consts.FieldProduct: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The product the license was issued for.",
+			},
+			consts.FieldFeatures: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The features enabled by the license.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldPerformanceStandbyCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of performance standby nodes allowed by the license.",
+			},
+			consts.FieldUtilizationReportJSON: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The license utilization report, JSON encoded.",
+			},
+		},
+	}
+}
+
+func licenseStatusDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading license status from Vault")
+	resp, err := client.Logical().Read(licenseStatusPath)
+	if err != nil {
+		return diag.Errorf("error reading license status from Vault: %s", err)
+	}
+
+	if resp == nil {
+		return diag.Errorf("no license status found at %q", licenseStatusPath)
+	}
+
+	// autoloaded licenses are the only kind supported since Vault 1.11,
+	// older versions report the stored license under persisted_autoload.
+	license, ok := resp.Data["autoloaded"].(map[string]interface{})
+	if !ok {
+		license, ok = resp.Data["persisted_autoload"].(map[string]interface{})
+		if !ok {
+			return diag.Errorf("no license found in the license status response")
+		}
+	}
+
+	for _, k := range licenseStatusFields {
+		if err := d.Set(k, license[k]); err != nil {
+			return diag.Errorf("error setting state key %q on license status, err=%s", k, err)
+		}
+	}
+
+	if err := d.Set(consts.FieldFeatures, license[consts.FieldFeatures]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := license[consts.FieldPerformanceStandbyCount].(json.Number); ok {
+		count, err := v.Int64()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(consts.FieldPerformanceStandbyCount, count); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get(consts.FieldIncludeUtilizationReport).(bool) {
+		log.Printf("[DEBUG] Reading license utilization report from Vault")
+		report, err := client.Logical().Read(utilizationReportPath)
+		if err != nil {
+			return diag.Errorf("error reading license utilization report from Vault: %s", err)
+		}
+
+		reportJSON := ""
+		if report != nil {
+			b, err := json.Marshal(report.Data)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			reportJSON = string(b)
+		}
+
+		if err := d.Set(consts.FieldUtilizationReportJSON, reportJSON); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(licenseStatusPath)
+
+	return nil
+}
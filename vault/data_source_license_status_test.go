@@ -0,0 +1,33 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceLicenseStatus(t *testing.T) {
+	dataName := "data.vault_license_status.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_license_status" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldLicenseID),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldExpirationTime),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldStartTime),
+					resource.TestCheckResourceAttrSet(dataName, "features.#"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldUtilizationReportJSON, ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func mongodbAtlasAccessCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(readMongoDBAtlasAccessCredentials),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Description: "The MongoDB Atlas secret backend to generate programmatic API keys from.",
+				Required:    true,
+			},
+			consts.FieldRole: {
+				Type:        schema.TypeString,
+				Description: "The name of the role.",
+				Required:    true,
+			},
+			fieldMongoDBAtlasPublicKey: {
+				Type:        schema.TypeString,
+				Description: "The public key of the generated programmatic API key.",
+				Computed:    true,
+			},
+			fieldMongoDBAtlasPrivateKey: {
+				Type:        schema.TypeString,
+				Description: "The private key of the generated programmatic API key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			consts.FieldDescription: {
+				Type:        schema.TypeString,
+				Description: "The description Vault assigned to the programmatic API key.",
+				Computed:    true,
+			},
+			consts.FieldLeaseID: {
+				Type:        schema.TypeString,
+				Description: "The lease identifier assigned by Vault.",
+				Computed:    true,
+			},
+			consts.FieldLeaseDuration: {
+				Type:        schema.TypeInt,
+				Description: "The duration of the lease in seconds.",
+				Computed:    true,
+			},
+			consts.FieldLeaseRenewable: {
+				Type:        schema.TypeBool,
+				Description: "True if the duration of this lease can be extended through renewal.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func readMongoDBAtlasAccessCredentials(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	role := d.Get(consts.FieldRole).(string)
+	path := fmt.Sprintf("%s/creds/%s", backend, role)
+	secret, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading from Vault: %s", err)
+	}
+	if secret == nil {
+		return diag.Errorf("no role found at %q", path)
+	}
+
+	d.SetId(secret.LeaseID)
+	dataFields := []string{
+		fieldMongoDBAtlasPublicKey,
+		fieldMongoDBAtlasPrivateKey,
+		consts.FieldDescription,
+	}
+	for _, k := range dataFields {
+		if err := d.Set(k, secret.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q, err=%s", k, err)
+		}
+	}
+
+	if err := d.Set(consts.FieldLeaseID, secret.LeaseID); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s",
+			consts.FieldLeaseID, err)
+	}
+	if err := d.Set(consts.FieldLeaseDuration, secret.LeaseDuration); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s",
+			consts.FieldLeaseDuration, err)
+	}
+	if err := d.Set(consts.FieldLeaseRenewable, secret.Renewable); err != nil {
+		return diag.Errorf("error setting state key %q, err=%s",
+			consts.FieldLeaseRenewable, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceMongoDBAtlasAccessCredentials(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "MONGODB_ATLAS_PUBLIC_KEY", "MONGODB_ATLAS_PRIVATE_KEY", "MONGODB_ATLAS_PROJECT_ID")
+	publicKey, privateKey, projectID := values[0], values[1], values[2]
+
+	backend := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	dataSourceName := "data.vault_mongodbatlas_access_credentials.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceMongoDBAtlasAccessCredentialsConfig(backend, publicKey, privateKey, name, projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, fieldMongoDBAtlasPublicKey),
+					resource.TestCheckResourceAttrSet(dataSourceName, fieldMongoDBAtlasPrivateKey),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "lease_duration", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceMongoDBAtlasAccessCredentialsConfig(backend, publicKey, privateKey, name, projectID string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path        = "%s"
+  public_key  = "%s"
+  private_key = "%s"
+}
+
+resource "vault_mongodbatlas_secret_backend_role" "test" {
+  backend    = vault_mongodbatlas_secret_backend.test.path
+  name       = "%s"
+  project_id = "%s"
+  roles      = ["GROUP_READ_ONLY"]
+  ttl        = 3600
+}
+
+data "vault_mongodbatlas_access_credentials" "test" {
+  backend = vault_mongodbatlas_secret_backend.test.path
+  role    = vault_mongodbatlas_secret_backend_role.test.name
+}
+`, backend, publicKey, privateKey, name, projectID)
+}
@@ -0,0 +1,194 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func mountsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(mountsDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return mounts of this secrets engine type, e.g. 'kv'.",
+			},
+			consts.FieldPaths: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The paths of all matching mounts.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldMounts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The details of all matching mounts, ordered by path.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldPath: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The mount path.",
+						},
+						consts.FieldType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The secrets engine type.",
+						},
+						consts.FieldDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the mount.",
+						},
+						consts.FieldAccessor: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The accessor of the mount.",
+						},
+						consts.FieldLocal: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the mount is local only.",
+						},
+						consts.FieldSealWrap: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether seal wrapping is enabled for the mount.",
+						},
+						consts.FieldExternalEntropyAccess: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the mount has access to Vault's external entropy source.",
+						},
+						consts.FieldOptions: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The mount type specific options.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldDefaultLeaseTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Default lease duration in seconds.",
+						},
+						consts.FieldMaxLeaseTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum possible lease duration in seconds.",
+						},
+						consts.FieldAuditNonHMACRequestKeys: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Keys that are not HMAC'd by audit devices in the request data object.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldAuditNonHMACResponseKeys: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Keys that are not HMAC'd by audit devices in the response data object.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldPassthroughRequestHeaders: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Request headers that are passed through to the secrets engine.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldAllowedResponseHeaders: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Response headers that the secrets engine is allowed to set.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						consts.FieldListingVisibility: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the mount is shown in the UI-specific listing endpoint.",
+						},
+						consts.FieldAllowedManagedKeys: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Managed key registry entry names that the mount is allowed to access.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mountsDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Listing mounts from Vault")
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return diag.Errorf("error reading mounts from Vault: %s", err)
+	}
+
+	mountType := d.Get(consts.FieldType).(string)
+
+	var paths []string
+	for path, mount := range mounts {
+		if mountType != "" && mount.Type != mountType {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var result []map[string]interface{}
+	var resultPaths []string
+	for _, path := range paths {
+		mount := mounts[path]
+		path = strings.TrimSuffix(path, consts.PathDelim)
+		resultPaths = append(resultPaths, path)
+		result = append(result, map[string]interface{}{
+			consts.FieldPath:                      path,
+			consts.FieldType:                      mount.Type,
+			consts.FieldDescription:               mount.Description,
+			consts.FieldAccessor:                  mount.Accessor,
+			consts.FieldLocal:                     mount.Local,
+			consts.FieldSealWrap:                  mount.SealWrap,
+			consts.FieldExternalEntropyAccess:     mount.ExternalEntropyAccess,
+			consts.FieldOptions:                   mount.Options,
+			consts.FieldDefaultLeaseTTL:           mount.Config.DefaultLeaseTTL,
+			consts.FieldMaxLeaseTTL:               mount.Config.MaxLeaseTTL,
+			consts.FieldAuditNonHMACRequestKeys:   mount.Config.AuditNonHMACRequestKeys,
+			consts.FieldAuditNonHMACResponseKeys:  mount.Config.AuditNonHMACResponseKeys,
+			consts.FieldPassthroughRequestHeaders: mount.Config.PassthroughRequestHeaders,
+			consts.FieldAllowedResponseHeaders:    mount.Config.AllowedResponseHeaders,
+			consts.FieldListingVisibility:         mount.Config.ListingVisibility,
+			consts.FieldAllowedManagedKeys:        mount.Config.AllowedManagedKeys,
+		})
+	}
+
+	if err := d.Set(consts.FieldPaths, resultPaths); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldMounts, result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := "sys/mounts"
+	if mountType != "" {
+		id += "?type=" + mountType
+	}
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceMounts(t *testing.T) {
+	kvPath := acctest.RandomWithPrefix("tf-test-kv")
+	transitPath := acctest.RandomWithPrefix("tf-test-transit")
+	dataName := "data.vault_mounts.test"
+	dataNameFiltered := "data.vault_mounts.filtered"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceMountsConfig(kvPath, transitPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataName, "paths.*", kvPath),
+					resource.TestCheckTypeSetElemAttr(dataName, "paths.*", transitPath),
+					resource.TestCheckTypeSetElemAttr(dataName, "paths.*", "sys"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataName, "mounts.*", map[string]string{
+						consts.FieldPath:                kvPath,
+						consts.FieldType:                "kv",
+						consts.FieldDescription:         "test kv mount",
+						consts.FieldMaxLeaseTTL:         "7200",
+						"options.%":                     "1",
+						"options.version":               "2",
+						"audit_non_hmac_request_keys.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataName, "mounts.*", map[string]string{
+						consts.FieldPath: transitPath,
+						consts.FieldType: "transit",
+					}),
+					resource.TestCheckTypeSetElemAttr(dataNameFiltered, "paths.*", transitPath),
+					resource.TestCheckTypeSetElemAttrPair(dataNameFiltered, "mounts.*.accessor",
+						"vault_mount.transit", "accessor"),
+					testCheckMountsDataSourceType(dataNameFiltered, "transit"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceMountsConfig(kvPath, transitPath string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kv" {
+  path                        = "%s"
+  type                        = "kv"
+  description                 = "test kv mount"
+  max_lease_ttl_seconds       = 7200
+  audit_non_hmac_request_keys = ["foo"]
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+data "vault_mounts" "test" {
+  depends_on = [vault_mount.kv, vault_mount.transit]
+}
+
+data "vault_mounts" "filtered" {
+  type       = "transit"
+  depends_on = [vault_mount.kv, vault_mount.transit]
+}
+`, kvPath, transitPath)
+}
+
+func testCheckMountsDataSourceType(resourceName, mountType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "mounts.") && strings.HasSuffix(k, ".type") && v != mountType {
+				return fmt.Errorf("expected only mounts of type %q, found %q at %s", mountType, v, k)
+			}
+		}
+
+		return nil
+	}
+}
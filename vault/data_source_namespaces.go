@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func namespacesDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(namespacesDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldRecursive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, also list the namespaces nested in the child namespaces.",
+			},
+			consts.FieldPaths: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The paths of the child namespaces, relative to the namespace of the data source.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldNamespaces: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The details of the child namespaces, ordered by path.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldPath: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The path of the namespace within its parent namespace.",
+						},
+						consts.FieldParent: {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "The path of the parent namespace, relative to the namespace " +
+								"of the data source. Empty for direct children.",
+						},
+						consts.FieldPathFQ: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The path of the namespace, relative to the namespace of the data source.",
+						},
+						consts.FieldNamespaceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the namespace.",
+						},
+						consts.FieldCustomMetadata: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The custom metadata of the namespace.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func namespacesDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	recursive := d.Get(consts.FieldRecursive).(bool)
+	base := d.Get(consts.FieldNamespace).(string)
+
+	var namespaces []map[string]interface{}
+	parents := []string{""}
+	for len(parents) > 0 {
+		parent := parents[0]
+		parents = parents[1:]
+
+		c := client
+		if parent != "" {
+			var err error
+			c, err = meta.(*provider.ProviderMeta).GetNSClient(strings.Trim(base+"/"+parent, "/"))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		children, err := listChildNamespaces(c, parent)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, child := range children {
+			namespaces = append(namespaces, child)
+			if recursive {
+				parents = append(parents, child[consts.FieldPathFQ].(string))
+			}
+		}
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i][consts.FieldPathFQ].(string) < namespaces[j][consts.FieldPathFQ].(string)
+	})
+
+	paths := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		paths = append(paths, ns[consts.FieldPathFQ].(string))
+	}
+
+	if err := d.Set(consts.FieldPaths, paths); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldNamespaces, namespaces); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.TrimSuffix(SysNamespaceRoot, "/"))
+
+	return nil
+}
+
+// listChildNamespaces lists the namespaces that are direct children of the
+// client's namespace, parent is the path of that namespace relative to the
+// data source.
+func listChildNamespaces(client *api.Client, parent string) ([]map[string]interface{}, error) {
+	log.Printf("[DEBUG] Listing child namespaces of %q", parent)
+	resp, err := client.Logical().List(SysNamespaceRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	keyInfo, _ := resp.Data["key_info"].(map[string]interface{})
+	keys, _ := resp.Data["keys"].([]interface{})
+
+	var result []map[string]interface{}
+	for _, k := range keys {
+		key := k.(string)
+		path := util.TrimSlashes(key)
+
+		pathFQ := path
+		if parent != "" {
+			pathFQ = parent + "/" + path
+		}
+
+		ns := map[string]interface{}{
+			consts.FieldPath:   path,
+			consts.FieldParent: parent,
+			consts.FieldPathFQ: pathFQ,
+		}
+		if info, ok := keyInfo[key].(map[string]interface{}); ok {
+			ns[consts.FieldNamespaceID] = info["id"]
+			ns[consts.FieldCustomMetadata] = info[consts.FieldCustomMetadata]
+		}
+
+		result = append(result, ns)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceNamespaces(t *testing.T) {
+	parent := acctest.RandomWithPrefix("tf-test-ns")
+	dataName := "data.vault_namespaces.test"
+	dataNameRecursive := "data.vault_namespaces.recursive"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(parent),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceNamespacesConfig(parent),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "paths.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "paths.0", "child"),
+					resource.TestCheckResourceAttr(dataNameRecursive, "paths.#", "2"),
+					resource.TestCheckResourceAttr(dataNameRecursive, "paths.0", "child"),
+					resource.TestCheckResourceAttr(dataNameRecursive, "paths.1", "child/grandchild"),
+					resource.TestCheckResourceAttr(dataNameRecursive, "namespaces.1.path", "grandchild"),
+					resource.TestCheckResourceAttr(dataNameRecursive, "namespaces.1.parent", "child"),
+					resource.TestCheckResourceAttrPair(dataNameRecursive, "namespaces.1.namespace_id",
+						"vault_namespace.grandchild", consts.FieldNamespaceID),
+				),
+			},
+			{
+				ResourceName:      "vault_namespace.grandchild",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/child/grandchild", parent),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDataSourceNamespacesConfig(parent string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "parent" {
+  path = "%s"
+}
+
+resource "vault_namespace" "child" {
+  namespace = vault_namespace.parent.path
+  path      = "child"
+}
+
+resource "vault_namespace" "grandchild" {
+  namespace = vault_namespace.child.path_fq
+  path      = "grandchild"
+}
+
+data "vault_namespaces" "test" {
+  namespace  = vault_namespace.parent.path
+  depends_on = [vault_namespace.grandchild]
+}
+
+data "vault_namespaces" "recursive" {
+  namespace  = vault_namespace.parent.path
+  recursive  = true
+  depends_on = [vault_namespace.grandchild]
+}
+`, parent)
+}
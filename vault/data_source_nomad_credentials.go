@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -34,6 +35,21 @@ func nomadAccessCredentialsDataSource() *schema.Resource {
 				Computed:    true,
 				Description: "Used to make requests to Nomad and should be kept private.",
 			},
+			consts.FieldLeaseID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by Vault.",
+			},
+			consts.FieldLeaseDuration: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds.",
+			},
+			consts.FieldLeaseRenewable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
 		},
 	}
 }
@@ -71,6 +87,9 @@ func readNomadCredsResource(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(accessorID)
 	d.Set("accessor_id", accessorID)
 	d.Set("secret_id", secretID)
+	d.Set(consts.FieldLeaseID, secret.LeaseID)
+	d.Set(consts.FieldLeaseDuration, secret.LeaseDuration)
+	d.Set(consts.FieldLeaseRenewable, secret.Renewable)
 
 	return nil
 }
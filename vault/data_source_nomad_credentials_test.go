@@ -23,6 +23,7 @@ func TestAccDataSourceNomadAccessCredentialsClientBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.token", "secret_id"),
 					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.token", "accessor_id"),
+					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.token", "lease_id"),
 				),
 			},
 		},
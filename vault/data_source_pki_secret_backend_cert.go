@@ -0,0 +1,147 @@
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCertDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(pkiSecretBackendCertDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend to read the certificate from.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The serial number of the certificate.",
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate.",
+			},
+			consts.FieldIssuerID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer which signed the certificate.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject of the certificate.",
+			},
+			"issuer_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject of the issuer of the certificate.",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start of the validity of the certificate, in RFC3339 format.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration of the certificate, in RFC3339 format.",
+			},
+			"revocation_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The revocation time of the certificate as a Unix-style timestamp, 0 if not revoked.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	serialNumber := d.Get("serial_number").(string)
+
+	data, cert, err := pkiSecretBackendReadCert(client, backend, serialNumber)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(pkiSecretBackendCertSerialPath(backend, serialNumber))
+
+	var revocationTime int64
+	if v, ok := data["revocation_time"].(json.Number); ok {
+		revocationTime, _ = v.Int64()
+	}
+
+	fields := map[string]interface{}{
+		consts.FieldCertificate: data[consts.FieldCertificate],
+		consts.FieldIssuerID:    data[consts.FieldIssuerID],
+		"subject":               cert.Subject.String(),
+		"issuer_subject":        cert.Issuer.String(),
+		"not_before":            cert.NotBefore.UTC().Format(time.RFC3339),
+		"not_after":             cert.NotAfter.UTC().Format(time.RFC3339),
+		"revocation_time":       revocationTime,
+	}
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendCertSerialPath(backend, serialNumber string) string {
+	return strings.Trim(backend, "/") + "/cert/" + serialNumber
+}
+
+// pkiSecretBackendReadCert reads the certificate with the given serial number
+// and returns the response data along with the parsed certificate.
+func pkiSecretBackendReadCert(client *api.Client, backend, serialNumber string) (map[string]interface{}, *x509.Certificate, error) {
+	path := pkiSecretBackendCertSerialPath(backend, serialNumber)
+
+	log.Printf("[DEBUG] Reading PKI certificate %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading PKI certificate %q: %w", path, err)
+	}
+	if resp == nil {
+		return nil, nil, fmt.Errorf("no PKI certificate found at %q", path)
+	}
+
+	certPEM, _ := resp.Data[consts.FieldCertificate].(string)
+	b, _ := pem.Decode([]byte(certPEM))
+	if b == nil {
+		return nil, nil, fmt.Errorf("invalid PEM certificate at %q", path)
+	}
+
+	cert, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing PKI certificate %q: %w", path, err)
+	}
+
+	return resp.Data, cert, nil
+}
@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCertMetadataDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(pkiSecretBackendCertMetadataDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend to read the certificate metadata from.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The serial number of the certificate.",
+			},
+			"cert_metadata": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded metadata of the certificate.",
+			},
+			consts.FieldIssuerID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer which signed the certificate.",
+			},
+			consts.FieldRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The role the certificate was issued against.",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration of the certificate.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertMetadataDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion117) {
+		return diag.Errorf("PKI certificate metadata requires Vault %s or later", provider.VaultVersion117)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	path := backend + "/cert-metadata/" + d.Get("serial_number").(string)
+
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI certificate metadata %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("no PKI certificate metadata found at %q", path)
+	}
+
+	d.SetId(path)
+
+	for _, k := range []string{
+		"cert_metadata",
+		consts.FieldIssuerID,
+		consts.FieldRole,
+		"expiration",
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourcePkiSecretBackendCertMetadata(t *testing.T) {
+	testutil.SkipTestAccEnt(t)
+
+	backend := acctest.RandomWithPrefix("pki")
+	dataName := "data.vault_pki_secret_backend_cert_metadata.test"
+	metadata := base64.StdEncoding.EncodeToString([]byte(`{"owner":"tf"}`))
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.17") {
+				t.Skip("PKI certificate metadata requires Vault 1.17 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePkiSecretBackendCertMetadataConfig(backend, metadata),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "cert_metadata", metadata),
+					resource.TestCheckResourceAttr(dataName, consts.FieldRole, "test"),
+					resource.TestCheckResourceAttrPair(dataName, "serial_number",
+						"vault_pki_secret_backend_cert.test", "serial_number"),
+					resource.TestCheckResourceAttrPair(dataName, consts.FieldIssuerID,
+						"vault_pki_secret_backend_cert.test", "issuer_id"),
+					resource.TestCheckResourceAttrSet(dataName, "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePkiSecretBackendCertMetadataConfig(backend, metadata string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend           = vault_pki_secret_backend_root_cert.test.backend
+  name              = "test"
+  allowed_domains   = ["test.my.domain"]
+  allow_subdomains  = true
+  no_store_metadata = false
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend       = vault_pki_secret_backend_role.test.backend
+  name          = vault_pki_secret_backend_role.test.name
+  common_name   = "cert.test.my.domain"
+  ttl           = "1h"
+  cert_metadata = "%s"
+}
+
+data "vault_pki_secret_backend_cert_metadata" "test" {
+  backend       = vault_pki_secret_backend_cert.test.backend
+  serial_number = vault_pki_secret_backend_cert.test.serial_number
+}
+`, backend, metadata)
+}
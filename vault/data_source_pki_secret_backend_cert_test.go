@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourcePkiSecretBackendCert(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	dataName := "data.vault_pki_secret_backend_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePkiSecretBackendCertConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataName, consts.FieldCertificate,
+						"vault_pki_secret_backend_cert.test", consts.FieldCertificate),
+					resource.TestCheckResourceAttr(dataName, "subject", "CN=cert.test.my.domain"),
+					resource.TestCheckResourceAttr(dataName, "issuer_subject", "CN=my.domain"),
+					resource.TestCheckResourceAttrSet(dataName, "not_before"),
+					resource.TestCheckResourceAttrSet(dataName, "not_after"),
+					resource.TestCheckResourceAttr(dataName, "revocation_time", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePkiSecretBackendCertConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "cert.test.my.domain"
+  ttl         = "1h"
+}
+
+data "vault_pki_secret_backend_cert" "test" {
+  backend       = vault_pki_secret_backend_cert.test.backend
+  serial_number = vault_pki_secret_backend_cert.test.serial_number
+}
+`, backend)
+}
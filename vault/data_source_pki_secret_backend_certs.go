@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCertsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(pkiSecretBackendCertsDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend to list the certificates of.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"revoked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list revoked certificates.",
+			},
+			"unexpired": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Only list certificates which have not expired yet, " +
+					"each certificate is read to check its expiration.",
+			},
+			"serial_numbers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The serial numbers of the certificates.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertsDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+
+	path := backend + "/certs"
+	if d.Get("revoked").(bool) {
+		if !provider.IsAPISupported(meta, provider.VaultVersion112) {
+			return diag.Errorf("listing revoked certificates requires Vault %s or later", provider.VaultVersion112)
+		}
+		path += "/revoked"
+	}
+
+	log.Printf("[DEBUG] Listing PKI certificates %q", path)
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return diag.Errorf("error listing PKI certificates %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Listed PKI certificates %q", path)
+
+	serialNumbers := []string{}
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, k := range keys {
+				serialNumbers = append(serialNumbers, k.(string))
+			}
+		}
+	}
+
+	if d.Get("unexpired").(bool) {
+		now := time.Now()
+		unexpired := []string{}
+		for _, serialNumber := range serialNumbers {
+			_, cert, err := pkiSecretBackendReadCert(client, backend, serialNumber)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if cert.NotAfter.After(now) {
+				unexpired = append(unexpired, serialNumber)
+			}
+		}
+		serialNumbers = unexpired
+	}
+
+	d.SetId(path)
+
+	if err := d.Set("serial_numbers", serialNumbers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourcePkiSecretBackendCerts(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("listing revoked certificates requires Vault 1.12 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePkiSecretBackendCertsConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					// the root CA and the leaf certificate
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_certs.all", "serial_numbers.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_certs.unexpired", "serial_numbers.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_certs.revoked", "serial_numbers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePkiSecretBackendCertsConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "cert.test.my.domain"
+  ttl         = "1h"
+}
+
+data "vault_pki_secret_backend_certs" "all" {
+  backend = vault_pki_secret_backend_cert.test.backend
+}
+
+data "vault_pki_secret_backend_certs" "unexpired" {
+  backend   = vault_pki_secret_backend_cert.test.backend
+  unexpired = true
+}
+
+data "vault_pki_secret_backend_certs" "revoked" {
+  backend = vault_pki_secret_backend_cert.test.backend
+  revoked = true
+}
+`, backend)
+}
@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var pluginsDataSourceFields = []string{
+	consts.FieldType,
+	consts.FieldName,
+	consts.FieldVersion,
+	consts.FieldSHA256,
+	consts.FieldBuiltin,
+	consts.FieldDeprecationStatus,
+	consts.FieldOCIImage,
+	consts.FieldRuntime,
+}
+
+func pluginsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(pluginsDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return plugins of this type, one of 'auth', 'database' or 'secret'.",
+				ValidateFunc: validation.StringInSlice(pluginTypes, false),
+			},
+			consts.FieldPlugins: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The plugins registered in the catalog.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the plugin.",
+						},
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the plugin.",
+						},
+						consts.FieldVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The semantic version of the plugin.",
+						},
+						consts.FieldSHA256: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The SHA256 sum of the plugin, empty for builtin plugins.",
+						},
+						consts.FieldBuiltin: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the plugin is built into Vault.",
+						},
+						consts.FieldDeprecationStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The deprecation status of builtin plugins.",
+						},
+						consts.FieldOCIImage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The OCI image of containerized plugins.",
+						},
+						consts.FieldRuntime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The plugin runtime of containerized plugins.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pluginsDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading plugin catalog from Vault")
+	resp, err := client.Logical().Read(pluginCatalogPath)
+	if err != nil {
+		return diag.Errorf("error reading plugin catalog from Vault: %s", err)
+	}
+
+	pluginType := d.Get(consts.FieldType).(string)
+
+	var plugins []map[string]interface{}
+	if resp != nil {
+		detailed, _ := resp.Data["detailed"].([]interface{})
+		for _, raw := range detailed {
+			p, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if pluginType != "" && p[consts.FieldType] != pluginType {
+				continue
+			}
+
+			plugin := map[string]interface{}{}
+			for _, k := range pluginsDataSourceFields {
+				if v, ok := p[k]; ok {
+					plugin[k] = v
+				}
+			}
+			plugins = append(plugins, plugin)
+		}
+	}
+
+	if err := d.Set(consts.FieldPlugins, plugins); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pluginCatalogPath
+	if pluginType != "" {
+		id += "?type=" + pluginType
+	}
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourcePlugins(t *testing.T) {
+	dataName := "data.vault_plugins.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("plugin catalog details require Vault 1.12 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_plugins" "test" {
+  type = "secret"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldType, "secret"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataName, "plugins.*", map[string]string{
+						consts.FieldName:    "kv",
+						consts.FieldType:    "secret",
+						consts.FieldBuiltin: "true",
+					}),
+					testCheckPluginsDataSourceType(dataName, "secret"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckPluginsDataSourceType(resourceName, pluginType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "plugins.") && strings.HasSuffix(k, ".type") && v != pluginType {
+				return fmt.Errorf("expected only plugins of type %q, found %q at %s", pluginType, v, k)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,208 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const autopilotStatePath = "sys/storage/raft/autopilot/state"
+
+var autopilotStateServerFields = []string{
+	consts.FieldID,
+	consts.FieldName,
+	consts.FieldAddress,
+	consts.FieldNodeStatus,
+	consts.FieldStatus,
+	consts.FieldHealthy,
+	consts.FieldLastContact,
+	consts.FieldLastTerm,
+	consts.FieldLastIndex,
+	consts.FieldVersion,
+}
+
+func raftAutopilotStateDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(raftAutopilotStateDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldHealthy: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all servers in the cluster are healthy.",
+			},
+			consts.FieldFailureTolerance: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of voting servers that can fail without losing quorum.",
+			},
+			consts.FieldOptimisticFailureTolerance: {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The number of healthy servers that can fail without losing quorum, " +
+					"counting non-voters that would be promoted.",
+			},
+			consts.FieldLeader: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current leader.",
+			},
+			consts.FieldVoters: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the voting servers.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldUpgradeStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the automated upgrade, only reported by Vault Enterprise.",
+			},
+			consts.FieldUpgradeTargetVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version the cluster is being upgraded to, only reported by Vault Enterprise.",
+			},
+			consts.FieldServers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The state of each server in the cluster, ordered by ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the server.",
+						},
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the server.",
+						},
+						consts.FieldAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cluster address of the server.",
+						},
+						consts.FieldNodeStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the server's node, e.g. 'alive'.",
+						},
+						consts.FieldStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The raft status of the server, e.g. 'leader' or 'voter'.",
+						},
+						consts.FieldHealthy: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the server is healthy.",
+						},
+						consts.FieldLastContact: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time since the server last had contact with the leader.",
+						},
+						consts.FieldLastTerm: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The last known raft term of the server.",
+						},
+						consts.FieldLastIndex: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The last known raft index of the server.",
+						},
+						consts.FieldVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vault version of the server.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func raftAutopilotStateDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading %q", autopilotStatePath)
+	resp, err := client.Logical().Read(autopilotStatePath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", autopilotStatePath, err)
+	}
+
+	if resp == nil {
+		return diag.Errorf("no autopilot state found at %q", autopilotStatePath)
+	}
+
+	for _, k := range []string{
+		consts.FieldHealthy,
+		consts.FieldFailureTolerance,
+		consts.FieldOptimisticFailureTolerance,
+		consts.FieldLeader,
+		consts.FieldVoters,
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on autopilot state, err=%s", k, err)
+		}
+	}
+
+	var upgradeStatus, upgradeTargetVersion interface{}
+	if upgrade, ok := resp.Data["upgrade_info"].(map[string]interface{}); ok {
+		upgradeStatus = upgrade[consts.FieldStatus]
+		upgradeTargetVersion = upgrade["target_version"]
+	}
+
+	if err := d.Set(consts.FieldUpgradeStatus, upgradeStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldUpgradeTargetVersion, upgradeTargetVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	rawServers, _ := resp.Data[consts.FieldServers].(map[string]interface{})
+	ids := make([]string, 0, len(rawServers))
+	for id := range rawServers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var servers []map[string]interface{}
+	for _, id := range ids {
+		raw, ok := rawServers[id].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		server := map[string]interface{}{}
+		for _, k := range autopilotStateServerFields {
+			if v, ok := raw[k]; ok {
+				server[k] = v
+			}
+		}
+		servers = append(servers, server)
+	}
+
+	if err := d.Set(consts.FieldServers, servers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(autopilotStatePath)
+
+	return nil
+}
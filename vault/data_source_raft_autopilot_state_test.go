@@ -0,0 +1,38 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceRaftAutopilotState(t *testing.T) {
+	dataName := "data.vault_raft_autopilot_state.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, "SKIP_RAFT_TESTS")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_raft_autopilot_state" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldHealthy, "true"),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldFailureTolerance),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldLeader),
+					resource.TestCheckResourceAttrSet(dataName, "voters.#"),
+					resource.TestCheckResourceAttrPair(dataName, "servers.0.id", dataName, consts.FieldLeader),
+					resource.TestCheckResourceAttr(dataName, "servers.0.status", "leader"),
+					resource.TestCheckResourceAttr(dataName, "servers.0.healthy", "true"),
+				),
+			},
+		},
+	})
+}
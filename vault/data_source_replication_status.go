@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const replicationStatusPath = "sys/replication/status"
+
+func replicationStatusDataSource() *schema.Resource {
+	statusSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					consts.FieldMode: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The replication mode, e.g. 'primary', 'secondary' or 'disabled'.",
+					},
+					consts.FieldState: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The replication state, e.g. 'running' or 'stream-wals'.",
+					},
+					consts.FieldClusterID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the replication cluster.",
+					},
+					consts.FieldPrimaryClusterAddr: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The cluster address of the primary.",
+					},
+					consts.FieldLastWAL: {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The index of the last WAL entry written on the primary.",
+					},
+					consts.FieldLastRemoteWAL: {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The index of the last WAL entry received from the primary, set on secondaries.",
+					},
+					consts.FieldKnownSecondaries: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "The IDs of the secondaries known to the primary.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(replicationStatusDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldDR:          statusSchema("The status of disaster recovery replication."),
+			consts.FieldPerformance: statusSchema("The status of performance replication."),
+		},
+	}
+}
+
+func replicationStatusDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading %q", replicationStatusPath)
+	resp, err := client.Logical().Read(replicationStatusPath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", replicationStatusPath, err)
+	}
+
+	if resp == nil {
+		return diag.Errorf("no replication status found at %q", replicationStatusPath)
+	}
+
+	for _, k := range []string{consts.FieldDR, consts.FieldPerformance} {
+		var status []interface{}
+		if raw, ok := resp.Data[k].(map[string]interface{}); ok {
+			status = append(status, flattenReplicationStatus(raw))
+		}
+
+		if err := d.Set(k, status); err != nil {
+			return diag.Errorf("error setting state key %q on replication status, err=%s", k, err)
+		}
+	}
+
+	d.SetId(replicationStatusPath)
+
+	return nil
+}
+
+func flattenReplicationStatus(raw map[string]interface{}) map[string]interface{} {
+	status := map[string]interface{}{}
+	for _, k := range []string{
+		consts.FieldMode,
+		consts.FieldState,
+		consts.FieldClusterID,
+		consts.FieldPrimaryClusterAddr,
+		consts.FieldKnownSecondaries,
+	} {
+		if v, ok := raw[k]; ok {
+			status[k] = v
+		}
+	}
+
+	// WAL indexes are only reported when replication is enabled.
+	for _, k := range []string{consts.FieldLastWAL, consts.FieldLastRemoteWAL} {
+		if v, ok := raw[k].(json.Number); ok {
+			if i, err := v.Int64(); err == nil {
+				status[k] = i
+			}
+		}
+	}
+
+	return status
+}
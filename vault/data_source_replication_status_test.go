@@ -0,0 +1,29 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceReplicationStatus(t *testing.T) {
+	dataName := "data.vault_replication_status.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_replication_status" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "dr.#", "1"),
+					resource.TestCheckResourceAttrSet(dataName, "dr.0.mode"),
+					resource.TestCheckResourceAttr(dataName, "performance.#", "1"),
+					resource.TestCheckResourceAttrSet(dataName, "performance.0.mode"),
+				),
+			},
+		},
+	})
+}
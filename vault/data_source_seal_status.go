@@ -0,0 +1,118 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func sealStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(sealStatusDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the seal, e.g. 'shamir' or 'awskms'.",
+			},
+			consts.FieldInitialized: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault is initialized.",
+			},
+			consts.FieldSealed: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault is sealed.",
+			},
+			consts.FieldThreshold: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of key shares required to unseal, or recovery shares for auto-unseal.",
+			},
+			consts.FieldShares: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of key shares, or recovery shares for auto-unseal.",
+			},
+			consts.FieldProgress: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of key shares provided for the current unseal attempt.",
+			},
+			consts.FieldMigration: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a seal migration is in progress.",
+			},
+			consts.FieldRecoverySeal: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault uses recovery keys, i.e. is auto-unsealed.",
+			},
+			consts.FieldStorageType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the storage backend.",
+			},
+			consts.FieldVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Vault version of the node.",
+			},
+			consts.FieldClusterName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the cluster.",
+			},
+			consts.FieldClusterID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the cluster.",
+			},
+		},
+	}
+}
+
+func sealStatusDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading seal status")
+	resp, err := client.Sys().SealStatus()
+	if err != nil {
+		return diag.Errorf("error reading seal status: %s", err)
+	}
+
+	fields := map[string]interface{}{
+		consts.FieldType:         resp.Type,
+		consts.FieldInitialized:  resp.Initialized,
+		consts.FieldSealed:       resp.Sealed,
+		consts.FieldThreshold:    resp.T,
+		consts.FieldShares:       resp.N,
+		consts.FieldProgress:     resp.Progress,
+		consts.FieldMigration:    resp.Migration,
+		consts.FieldRecoverySeal: resp.RecoverySeal,
+		consts.FieldStorageType:  resp.StorageType,
+		consts.FieldVersion:      resp.Version,
+		consts.FieldClusterName:  resp.ClusterName,
+		consts.FieldClusterID:    resp.ClusterID,
+	}
+
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q on seal status, err=%s", k, err)
+		}
+	}
+
+	d.SetId("sys/seal-status")
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceSealStatus(t *testing.T) {
+	dataName := "data.vault_seal_status.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_seal_status" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldType),
+					resource.TestCheckResourceAttr(dataName, consts.FieldInitialized, "true"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldSealed, "false"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldMigration, "false"),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldThreshold),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldClusterID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func sshCertificateDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(sshCertificateDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SSH secret backend to sign the public key with.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the role to sign the public key against.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SSH public key to sign.",
+			},
+			"valid_principals": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Principals the certificate is valid for, user names or host names.",
+			},
+			"cert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "user",
+				ValidateFunc: validation.StringInSlice([]string{"user", "host"}, false),
+				Description:  "Type of certificate to sign, either user or host.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key ID of the certificate, only honored if the role allows user key IDs.",
+			},
+			consts.FieldTTL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Requested time to live of the certificate, capped by the role's max TTL.",
+			},
+			"critical_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Critical options of the certificate.",
+			},
+			"extensions": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Extensions of the certificate.",
+			},
+			"signed_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signed SSH certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the certificate.",
+			},
+		},
+	}
+}
+
+func sshCertificateDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	path := backend + "/sign/" + d.Get(consts.FieldName).(string)
+
+	data := map[string]interface{}{
+		"public_key": d.Get("public_key"),
+		"cert_type":  d.Get("cert_type"),
+	}
+	if v, ok := d.GetOk("valid_principals"); ok {
+		data["valid_principals"] = strings.Join(util.ToStringArray(v.([]interface{})), ",")
+	}
+	for _, k := range []string{"key_id", consts.FieldTTL, "critical_options", "extensions"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Signing SSH public key on %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return diag.Errorf("error signing SSH public key on %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("empty response signing SSH public key on %q", path)
+	}
+	log.Printf("[DEBUG] Signed SSH public key on %q", path)
+
+	serialNumber, _ := resp.Data["serial_number"].(string)
+	d.SetId(backend + "/sign/" + serialNumber)
+
+	if err := d.Set("signed_key", resp.Data["signed_key"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("serial_number", serialNumber); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
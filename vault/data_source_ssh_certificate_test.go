@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceSSHCertificate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("ssh")
+	dataName := "data.vault_ssh_certificate.test"
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSSHCertificateConfig(backend, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataName, "signed_key",
+						regexp.MustCompile("^ssh-ed25519-cert-v01@openssh.com ")),
+					resource.TestCheckResourceAttrSet(dataName, "serial_number"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceSSHCertificateConfig(backend, publicKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.test.path
+  generate_signing_key = true
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend                 = vault_ssh_secret_backend_ca.test.backend
+  name                    = "test"
+  key_type                = "ca"
+  allow_user_certificates = true
+  allow_user_key_ids      = true
+  allowed_users           = "ubuntu"
+  ttl                     = "3600"
+}
+
+data "vault_ssh_certificate" "test" {
+  backend          = vault_ssh_secret_backend_role.test.backend
+  name             = vault_ssh_secret_backend_role.test.name
+  public_key       = "%s"
+  valid_principals = ["ubuntu"]
+  key_id           = "terraform"
+  ttl              = "10m"
+}
+`, backend, publicKey)
+}
@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func sshOTPCredentialDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(sshOTPCredentialDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SSH secret backend to generate the OTP from.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldRole: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the OTP role.",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IP of the remote host.",
+			},
+			consts.FieldUsername: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Username on the remote host, defaults to the role's default user.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The one-time password.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The SSH port of the remote host.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by Vault.",
+			},
+		},
+	}
+}
+
+func sshOTPCredentialDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	path := backend + "/creds/" + d.Get(consts.FieldRole).(string)
+
+	data := map[string]interface{}{
+		"ip": d.Get("ip"),
+	}
+	if v, ok := d.GetOk(consts.FieldUsername); ok {
+		data[consts.FieldUsername] = v
+	}
+
+	log.Printf("[DEBUG] Generating SSH OTP on %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return diag.Errorf("error generating SSH OTP on %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("empty response generating SSH OTP on %q", path)
+	}
+	log.Printf("[DEBUG] Generated SSH OTP on %q", path)
+
+	d.SetId(resp.LeaseID)
+
+	var port int64
+	if v, ok := resp.Data["port"].(json.Number); ok {
+		port, _ = v.Int64()
+	}
+
+	fields := map[string]interface{}{
+		"key":                resp.Data["key"],
+		"port":               port,
+		consts.FieldUsername: resp.Data[consts.FieldUsername],
+		"lease_id":           resp.LeaseID,
+	}
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceSSHOTPCredential(t *testing.T) {
+	backend := acctest.RandomWithPrefix("ssh")
+	dataName := "data.vault_ssh_otp_credential.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSSHOTPCredentialConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataName, "key"),
+					resource.TestCheckResourceAttrSet(dataName, "lease_id"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldUsername, "usr"),
+					resource.TestCheckResourceAttr(dataName, "port", "22"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceSSHOTPCredentialConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  name          = "otp"
+  backend       = vault_mount.test.path
+  allowed_users = "usr"
+  default_user  = "usr"
+  key_type      = "otp"
+  cidr_list     = "10.0.0.0/8"
+}
+
+data "vault_ssh_otp_credential" "test" {
+  backend = vault_ssh_secret_backend_role.test.backend
+  role    = vault_ssh_secret_backend_role.test.name
+  ip      = "10.0.0.1"
+}
+`, backend)
+}
@@ -0,0 +1,116 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func terraformCloudAccessTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(terraformCloudAccessTokenDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Terraform Cloud secret backend to generate tokens from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the role.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Terraform Cloud or Enterprise API token generated by Vault.",
+			},
+			"token_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the generated token.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Terraform Cloud or Enterprise organization the token belongs to.",
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Terraform Cloud or Enterprise team the token belongs to.",
+			},
+			consts.FieldLeaseID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by Vault. Only set for user tokens.",
+			},
+			consts.FieldLeaseDuration: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds.",
+			},
+			consts.FieldLeaseRenewable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func terraformCloudAccessTokenDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	role := d.Get("role").(string)
+	path := fmt.Sprintf("%s/creds/%s", backend, role)
+
+	log.Printf("[DEBUG] Reading Terraform Cloud token from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read Terraform Cloud token from %q", path)
+
+	if secret == nil {
+		return diag.Errorf("no role found at %q", path)
+	}
+
+	token, _ := secret.Data["token"].(string)
+	if token == "" {
+		return diag.Errorf("token is not set in response")
+	}
+	tokenID, _ := secret.Data["token_id"].(string)
+
+	d.SetId(tokenID)
+
+	data := map[string]interface{}{
+		"token":                    token,
+		"token_id":                 tokenID,
+		"organization":             secret.Data["organization"],
+		"team_id":                  secret.Data["team_id"],
+		consts.FieldLeaseID:        secret.LeaseID,
+		consts.FieldLeaseDuration:  secret.LeaseDuration,
+		consts.FieldLeaseRenewable: secret.Renewable,
+	}
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
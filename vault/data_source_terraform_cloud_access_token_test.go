@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTerraformCloudAccessToken(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-backend")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	values := testutil.SkipTestEnvUnset(t, "TEST_TF_TOKEN", "TEST_TF_ORGANIZATION")
+	token, organization := values[0], values[1]
+
+	dataSourceName := "data.vault_terraform_cloud_access_token.test"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		PreCheck:          func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTerraformCloudAccessTokenConfig(backend, token, name, organization),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "organization", organization),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token_id"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTerraformCloudAccessTokenConfig(backend, token, name, organization string) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  backend = "%s"
+  token   = "%s"
+}
+
+resource "vault_terraform_cloud_secret_role" "test" {
+  backend      = vault_terraform_cloud_secret_backend.test.backend
+  name         = "%s"
+  organization = "%s"
+}
+
+data "vault_terraform_cloud_access_token" "test" {
+  backend = vault_terraform_cloud_secret_backend.test.backend
+  role    = vault_terraform_cloud_secret_role.test.name
+}
+`, backend, token, name, organization)
+}
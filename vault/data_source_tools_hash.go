@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func toolsHashDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(toolsHashDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			"input": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base64 encoded input data.",
+			},
+			"algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sha2-256",
+				Description: "The hash algorithm to use, one of sha2-224, sha2-256, " +
+					"sha2-384, sha2-512, sha3-224, sha3-256, sha3-384 or sha3-512.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"sha2-224", "sha2-256", "sha2-384", "sha2-512",
+					"sha3-224", "sha3-256", "sha3-384", "sha3-512",
+				}, false),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hex",
+				Description:  "The output encoding, either hex or base64.",
+				ValidateFunc: validation.StringInSlice([]string{"hex", "base64"}, false),
+			},
+			"sum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hash of the input.",
+			},
+		},
+	}
+}
+
+func toolsHashDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := fmt.Sprintf("sys/tools/hash/%s", d.Get("algorithm").(string))
+	data := map[string]interface{}{
+		"input":  d.Get("input").(string),
+		"format": d.Get("format").(string),
+	}
+
+	log.Printf("[DEBUG] Hashing input with %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return diag.Errorf("error hashing input with %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("empty response from %q", path)
+	}
+
+	d.SetId(path)
+
+	if err := d.Set("sum", resp.Data["sum"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceToolsHash(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_tools_hash" "test" {
+  input = base64encode("vault")
+}
+
+data "vault_tools_hash" "sha512" {
+  input     = base64encode("vault")
+  algorithm = "sha2-512"
+  format    = "base64"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					// echo -n vault | sha256sum
+					resource.TestCheckResourceAttr("data.vault_tools_hash.test", "sum",
+						"e6f0a1fbb43c89196dcfcbef85908f19ab4c5f7cc4f4c452284697757683d7ef"),
+					resource.TestCheckResourceAttr("data.vault_tools_hash.sha512", "sum",
+						"wqDaFqL6+UMfw2LBAMqau/8MEALTs67OZwIxP1GywMxHkHwZMrXk4+006FTjWga2JXeNqA5WCX8yOGA/a2JeiA=="),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitDataKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: ReadWrapper(transitDataKeyDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key to wrap the data key with.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "wrapped",
+				Description: "Either 'wrapped' to only return the wrapped data key, " +
+					"or 'plaintext' to also return the plaintext data key.",
+				ValidateFunc: validation.StringInSlice([]string{"wrapped", "plaintext"}, false),
+			},
+			"bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      256,
+				Description:  "The number of bits in the data key, one of 128, 256 or 512.",
+				ValidateFunc: validation.IntInSlice([]int{128, 256, 512}),
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the context for key derivation",
+			},
+			"nonce": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base64 encoded nonce, only used with convergent encryption.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data key wrapped by the transit key.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded plaintext data key, only set when type is 'plaintext'.",
+				Sensitive:   true,
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the transit key used to wrap the data key.",
+			},
+		},
+	}
+}
+
+func transitDataKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	path := fmt.Sprintf("%s/datakey/%s/%s", backend, d.Get("type").(string), key)
+
+	payload := map[string]interface{}{
+		"bits":    d.Get("bits").(int),
+		"context": transitEncodeContext(d.Get("context").(string)),
+	}
+	if v, ok := d.GetOk("nonce"); ok {
+		payload["nonce"] = v.(string)
+	}
+
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue generating data key: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("empty response from %q", path)
+	}
+
+	d.SetId(path)
+
+	if err := d.Set("ciphertext", resp.Data["ciphertext"]); err != nil {
+		return err
+	}
+
+	if err := d.Set("key_version", resp.Data["key_version"]); err != nil {
+		return err
+	}
+
+	if v, ok := resp.Data["plaintext"]; ok {
+		if err := d.Set("plaintext", v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitDataKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitDataKeyConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_datakey.wrapped", "ciphertext",
+						regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttr("data.vault_transit_datakey.wrapped", "plaintext", ""),
+					resource.TestCheckResourceAttr("data.vault_transit_datakey.wrapped", "key_version", "1"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_datakey.plaintext", "ciphertext"),
+					// 512 bits base64 encoded
+					resource.TestMatchResourceAttr("data.vault_transit_datakey.plaintext", "plaintext",
+						regexp.MustCompile(`^[A-Za-z0-9+/]{86}==$`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitDataKeyConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_datakey" "wrapped" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+}
+
+data "vault_transit_datakey" "plaintext" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  type    = "plaintext"
+  bits    = 512
+}
+`, backend)
+}
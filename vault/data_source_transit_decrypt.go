@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -36,9 +37,42 @@ func transitDecryptDataSource() *schema.Resource {
 				Description: "Specifies the context for key derivation",
 			},
 			"ciphertext": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Transit encrypted cipher text.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Transit encrypted cipher text.",
+				ExactlyOneOf: []string{"ciphertext", "batch_input"},
+			},
+			"batch_input": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "List of items to decrypt in a single request.",
+				ExactlyOneOf: []string{"ciphertext", "batch_input"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ciphertext": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Transit encrypted cipher text.",
+						},
+						"context": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Specifies the context for key derivation",
+						},
+						"nonce": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Base64 encoded nonce, only used with convergent encryption.",
+						},
+					},
+				},
+			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The decrypted plain texts, in the order of batch_input.",
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
 	}
@@ -52,6 +86,11 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	backend := d.Get("backend").(string)
 	key := d.Get("key").(string)
+
+	if v, ok := d.GetOk("batch_input"); ok {
+		return transitDecryptBatch(d, client, backend, key, v.([]interface{}))
+	}
+
 	ciphertext := d.Get("ciphertext").(string)
 
 	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
@@ -72,3 +111,30 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func transitDecryptBatch(d *schema.ResourceData, client *api.Client, backend, key string, input []interface{}) error {
+	batch := transitBatchInput(input, "ciphertext")
+	payload := map[string]interface{}{
+		"batch_input": batch,
+	}
+
+	path := backend + "/decrypt/" + key
+	results, err := transitBatchWrite(client, path, payload, len(batch))
+	if err != nil {
+		return fmt.Errorf("issue decrypting with key: %s", err)
+	}
+
+	var plaintexts []string
+	for _, r := range results {
+		v, _ := r["plaintext"].(string)
+		plaintext, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return fmt.Errorf("error decoding plaintext: %s", err)
+		}
+		plaintexts = append(plaintexts, string(plaintext))
+	}
+
+	d.SetId(path)
+
+	return d.Set("batch_results", plaintexts)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -25,10 +26,11 @@ func transitEncryptDataSource() *schema.Resource {
 				Description: "The Transit secret backend the key belongs to.",
 			},
 			"plaintext": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Map of strings read from Vault.",
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Map of strings read from Vault.",
+				Sensitive:    true,
+				ExactlyOneOf: []string{"plaintext", "batch_input"},
 			},
 			"context": {
 				Type:        schema.TypeString,
@@ -45,6 +47,51 @@ func transitEncryptDataSource() *schema.Resource {
 				Computed:    true,
 				Description: "Transit encrypted cipher text.",
 			},
+			"batch_input": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "List of items to encrypt in a single request.",
+				ExactlyOneOf: []string{"plaintext", "batch_input"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plaintext": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Plain text to encrypt.",
+							Sensitive:   true,
+						},
+						"context": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Specifies the context for key derivation",
+						},
+						"nonce": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Base64 encoded nonce, only used with convergent encryption.",
+						},
+					},
+				},
+			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The results of the batch encryption, in the order of batch_input.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ciphertext": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Transit encrypted cipher text.",
+						},
+						"key_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version of the key used for encryption.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -59,6 +106,10 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	key := d.Get("key").(string)
 	keyVersion := d.Get("key_version").(int)
 
+	if v, ok := d.GetOk("batch_input"); ok {
+		return transitEncryptBatch(d, client, backend, key, keyVersion, v.([]interface{}))
+	}
+
 	plaintext := base64.StdEncoding.EncodeToString([]byte(d.Get("plaintext").(string)))
 	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
 	payload := map[string]interface{}{
@@ -79,3 +130,91 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func transitEncryptBatch(d *schema.ResourceData, client *api.Client, backend, key string, keyVersion int, input []interface{}) error {
+	batch := transitBatchInput(input, "plaintext")
+	payload := map[string]interface{}{
+		"batch_input": batch,
+		"key_version": keyVersion,
+	}
+
+	path := backend + "/encrypt/" + key
+	results, err := transitBatchWrite(client, path, payload, len(batch))
+	if err != nil {
+		return fmt.Errorf("issue encrypting with key: %s", err)
+	}
+
+	var batchResults []map[string]interface{}
+	for _, r := range results {
+		batchResults = append(batchResults, map[string]interface{}{
+			"ciphertext":  r["ciphertext"],
+			"key_version": r["key_version"],
+		})
+	}
+
+	d.SetId(path)
+
+	return d.Set("batch_results", batchResults)
+}
+
+// transitBatchInput converts the batch_input blocks to the request format,
+// the field named by encoded is base64 encoded along with the context.
+func transitBatchInput(input []interface{}, encoded string) []map[string]interface{} {
+	batch := make([]map[string]interface{}, 0, len(input))
+	for _, v := range input {
+		m := v.(map[string]interface{})
+
+		item := map[string]interface{}{
+			"context": transitEncodeContext(m["context"].(string)),
+		}
+		switch encoded {
+		case "plaintext":
+			item["plaintext"] = base64.StdEncoding.EncodeToString([]byte(m["plaintext"].(string)))
+		default:
+			item[encoded] = m[encoded]
+		}
+		if v, ok := m["nonce"].(string); ok && v != "" {
+			item["nonce"] = v
+		}
+
+		batch = append(batch, item)
+	}
+
+	return batch
+}
+
+// transitBatchWrite writes a batch request to path and returns the ordered
+// batch_results, failing if any of the items returned an error.
+func transitBatchWrite(client *api.Client, path string, payload map[string]interface{}, expected int) ([]map[string]interface{}, error) {
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("empty response from %q", path)
+	}
+
+	raw, _ := resp.Data["batch_results"].([]interface{})
+	if len(raw) != expected {
+		return nil, fmt.Errorf("expected %d batch results, got %d", expected, len(raw))
+	}
+
+	results := make([]map[string]interface{}, 0, len(raw))
+	for i, v := range raw {
+		r, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected batch result %v at index %d", v, i)
+		}
+		if e, ok := r["error"].(string); ok && e != "" {
+			return nil, fmt.Errorf("batch item %d: %s", i, e)
+		}
+		results = append(results, r)
+	}
+
+	return results, nil
+}
+
+// transitEncodeContext base64 encodes the key derivation context.
+func transitEncodeContext(context string) string {
+	return base64.StdEncoding.EncodeToString([]byte(context))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -66,3 +67,70 @@ func testDataSourceTransitEncrypt_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestDataSourceTransitEncrypt_batch(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitEncryptBatchConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_encrypt.test", "batch_results.#", "3"),
+					resource.TestCheckResourceAttr("data.vault_transit_encrypt.test", "batch_results.0.key_version", "1"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.#", "3"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.0", "foo"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.1", "bar"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.2", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitEncryptBatchConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+  derived          = true
+}
+
+locals {
+  items = ["foo", "bar", "baz"]
+}
+
+data "vault_transit_encrypt" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+
+  dynamic "batch_input" {
+    for_each = local.items
+    content {
+      plaintext = batch_input.value
+      context   = "ctx-${batch_input.value}"
+    }
+  }
+}
+
+data "vault_transit_decrypt" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+
+  dynamic "batch_input" {
+    for_each = data.vault_transit_encrypt.test.batch_results
+    content {
+      ciphertext = batch_input.value.ciphertext
+      context    = "ctx-${local.items[batch_input.key]}"
+    }
+  }
+}
+`, backend)
+}
@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitHMACDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: ReadWrapper(transitHMACDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to use.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"input": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base64 encoded input data.",
+				Sensitive:   true,
+			},
+			"algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sha2-256",
+				Description: "The hash algorithm to use, one of sha2-224, sha2-256, " +
+					"sha2-384, sha2-512, sha3-224, sha3-256, sha3-384 or sha3-512.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"sha2-224", "sha2-256", "sha2-384", "sha2-512",
+					"sha3-224", "sha3-256", "sha3-384", "sha3-512",
+				}, false),
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use, defaults to the latest version.",
+			},
+			"hmac": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The HMAC of the input, prefixed with the key version.",
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func transitHMACDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	path := backend + "/hmac/" + key
+
+	payload := map[string]interface{}{
+		"input":       d.Get("input").(string),
+		"algorithm":   d.Get("algorithm").(string),
+		"key_version": d.Get("key_version").(int),
+	}
+
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue generating HMAC with key: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("empty response from %q", path)
+	}
+
+	d.SetId(path)
+
+	return d.Set("hmac", resp.Data["hmac"])
+}
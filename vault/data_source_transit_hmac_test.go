@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitHMAC(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resourceName := "data.vault_transit_hmac.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitHMACConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "sha2-512"),
+					resource.TestMatchResourceAttr(resourceName, "hmac", regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttrPair(resourceName, "hmac", "data.vault_transit_hmac.again", "hmac"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitHMACConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_hmac" "test" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  algorithm = "sha2-512"
+  input     = base64encode("webhook")
+}
+
+data "vault_transit_hmac" "again" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  algorithm = "sha2-512"
+  input     = base64encode("webhook")
+}
+`, backend)
+}
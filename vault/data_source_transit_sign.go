@@ -0,0 +1,183 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var transitHashAlgorithms = []string{
+	"sha1", "sha2-224", "sha2-256", "sha2-384", "sha2-512",
+	"sha3-224", "sha3-256", "sha3-384", "sha3-512", "none",
+}
+
+// transitSignatureSchema returns the fields shared by the sign and verify
+// data sources.
+func transitSignatureSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the signing key to use.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Transit secret backend the key belongs to.",
+		},
+		"hash_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The hash algorithm to use, defaults to sha2-256.",
+			ValidateFunc: validation.StringInSlice(transitHashAlgorithms, false),
+		},
+		"signature_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The signature algorithm to use for RSA keys, either pss or pkcs1v15.",
+			ValidateFunc: validation.StringInSlice([]string{"pss", "pkcs1v15"}, false),
+		},
+		"marshaling_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The way in which the signature is marshaled for ECDSA keys, either asn1 or jws.",
+			ValidateFunc: validation.StringInSlice([]string{"asn1", "jws"}, false),
+		},
+		"prehashed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Set to true when the input is already hashed.",
+		},
+		"context": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the context for key derivation",
+		},
+	}
+}
+
+// transitSignaturePayload returns the request fields shared by the sign and
+// verify endpoints.
+func transitSignaturePayload(d *schema.ResourceData) map[string]interface{} {
+	payload := map[string]interface{}{
+		"prehashed": d.Get("prehashed").(bool),
+	}
+
+	for _, k := range []string{"hash_algorithm", "signature_algorithm", "marshaling_algorithm"} {
+		if v, ok := d.GetOk(k); ok {
+			payload[k] = v.(string)
+		}
+	}
+
+	return payload
+}
+
+func transitSignDataSource() *schema.Resource {
+	s := transitSignatureSchema()
+	s["input"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Base64 encoded input data to sign.",
+		ExactlyOneOf: []string{"input", "batch_input"},
+	}
+	s["key_version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The version of the key to use for signing",
+	}
+	s["batch_input"] = &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		Description:  "List of items to sign in a single request.",
+		ExactlyOneOf: []string{"input", "batch_input"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"input": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Base64 encoded input data to sign.",
+				},
+				"context": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the context for key derivation",
+				},
+			},
+		},
+	}
+	s["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The signature returned from Vault.",
+	}
+	s["batch_results"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The signatures, in the order of batch_input.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Read:   ReadWrapper(transitSignDataSourceRead),
+		Schema: s,
+	}
+}
+
+func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	path := backend + "/sign/" + key
+
+	payload := transitSignaturePayload(d)
+	payload["key_version"] = d.Get("key_version").(int)
+
+	if v, ok := d.GetOk("batch_input"); ok {
+		var batch []map[string]interface{}
+		for _, item := range v.([]interface{}) {
+			m := item.(map[string]interface{})
+			batch = append(batch, map[string]interface{}{
+				"input":   m["input"],
+				"context": transitEncodeContext(m["context"].(string)),
+			})
+		}
+		payload["batch_input"] = batch
+
+		results, err := transitBatchWrite(client, path, payload, len(batch))
+		if err != nil {
+			return fmt.Errorf("issue signing with key: %s", err)
+		}
+
+		var signatures []string
+		for _, r := range results {
+			sig, _ := r["signature"].(string)
+			signatures = append(signatures, sig)
+		}
+
+		d.SetId(path)
+
+		return d.Set("batch_results", signatures)
+	}
+
+	payload["input"] = d.Get("input").(string)
+	payload["context"] = transitEncodeContext(d.Get("context").(string))
+
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue signing with key: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("empty response from %q", path)
+	}
+
+	d.SetId(path)
+
+	return d.Set("signature", resp.Data["signature"])
+}
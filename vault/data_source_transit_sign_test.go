@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitSignVerify(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSignVerifyConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_sign.test", "signature",
+						regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.tampered", "valid", "false"),
+					resource.TestCheckResourceAttr("data.vault_transit_sign.batch", "batch_results.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.batch", "batch_results.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.batch", "batch_results.0", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.batch", "batch_results.1", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSignVerifyConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  type             = "ecdsa-p256"
+  deletion_allowed = true
+}
+
+data "vault_transit_sign" "test" {
+  backend        = vault_mount.test.path
+  key            = vault_transit_secret_backend_key.test.name
+  hash_algorithm = "sha2-512"
+  input          = base64encode("artifact")
+}
+
+data "vault_transit_verify" "test" {
+  backend        = vault_mount.test.path
+  key            = vault_transit_secret_backend_key.test.name
+  hash_algorithm = "sha2-512"
+  input          = base64encode("artifact")
+  signature      = data.vault_transit_sign.test.signature
+}
+
+data "vault_transit_verify" "tampered" {
+  backend        = vault_mount.test.path
+  key            = vault_transit_secret_backend_key.test.name
+  hash_algorithm = "sha2-512"
+  input          = base64encode("tampered")
+  signature      = data.vault_transit_sign.test.signature
+}
+
+locals {
+  inputs = [base64encode("foo"), base64encode("bar")]
+}
+
+data "vault_transit_sign" "batch" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+
+  dynamic "batch_input" {
+    for_each = local.inputs
+    content {
+      input = batch_input.value
+    }
+  }
+}
+
+data "vault_transit_verify" "batch" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+
+  dynamic "batch_input" {
+    for_each = data.vault_transit_sign.batch.batch_results
+    content {
+      input     = local.inputs[batch_input.key]
+      signature = batch_input.value
+    }
+  }
+}
+`, backend)
+}
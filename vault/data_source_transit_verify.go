@@ -0,0 +1,125 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitVerifyDataSource() *schema.Resource {
+	s := transitSignatureSchema()
+	s["input"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Base64 encoded input data to verify.",
+		RequiredWith:  []string{"signature"},
+		ConflictsWith: []string{"batch_input"},
+	}
+	s["signature"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "The signature to verify.",
+		RequiredWith:  []string{"input"},
+		ConflictsWith: []string{"batch_input"},
+	}
+	s["batch_input"] = &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		Description:  "List of items to verify in a single request.",
+		ExactlyOneOf: []string{"input", "batch_input"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"input": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Base64 encoded input data to verify.",
+				},
+				"signature": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The signature to verify.",
+				},
+				"context": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the context for key derivation",
+				},
+			},
+		},
+	}
+	s["valid"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the signature is valid.",
+	}
+	s["batch_results"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Whether each signature is valid, in the order of batch_input.",
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+	}
+
+	return &schema.Resource{
+		Read:   ReadWrapper(transitVerifyDataSourceRead),
+		Schema: s,
+	}
+}
+
+func transitVerifyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	path := backend + "/verify/" + key
+
+	payload := transitSignaturePayload(d)
+
+	if v, ok := d.GetOk("batch_input"); ok {
+		var batch []map[string]interface{}
+		for _, item := range v.([]interface{}) {
+			m := item.(map[string]interface{})
+			batch = append(batch, map[string]interface{}{
+				"input":     m["input"],
+				"signature": m["signature"],
+				"context":   transitEncodeContext(m["context"].(string)),
+			})
+		}
+		payload["batch_input"] = batch
+
+		results, err := transitBatchWrite(client, path, payload, len(batch))
+		if err != nil {
+			return fmt.Errorf("issue verifying with key: %s", err)
+		}
+
+		var valid []bool
+		for _, r := range results {
+			v, _ := r["valid"].(bool)
+			valid = append(valid, v)
+		}
+
+		d.SetId(path)
+
+		return d.Set("batch_results", valid)
+	}
+
+	payload["input"] = d.Get("input").(string)
+	payload["signature"] = d.Get("signature").(string)
+	payload["context"] = transitEncodeContext(d.Get("context").(string))
+
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue verifying with key: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("empty response from %q", path)
+	}
+
+	d.SetId(path)
+
+	return d.Set("valid", resp.Data["valid"])
+}
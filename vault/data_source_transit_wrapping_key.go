@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitWrappingKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadContextWrapper(transitWrappingKeyDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend to read the wrapping key from.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded public RSA wrapping key used to import keys.",
+			},
+		},
+	}
+}
+
+func transitWrappingKeyDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get("backend").(string)
+
+	publicKey, err := transitWrappingKey(client, backend)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(backend + "/wrapping_key")
+
+	if err := d.Set("public_key", publicKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitWrappingKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resourceName := "data.vault_transit_wrapping_key.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("the wrapping key requires Vault 1.11 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+data "vault_transit_wrapping_key" "test" {
+  backend = vault_mount.transit.path
+}
+`, backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/wrapping_key"),
+					resource.TestMatchResourceAttr(resourceName, "public_key",
+						regexp.MustCompile(`^-----BEGIN PUBLIC KEY-----`)),
+				),
+			},
+		},
+	})
+}
@@ -250,14 +250,26 @@ var (
 			Resource:      UpdateSchemaResource(adAccessCredentialsDataSource()),
 			PathInventory: []string{"/ad/creds/{role}"},
 		},
+		"vault_ad_secret_backend_ldap_config": {
+			Resource:      UpdateSchemaResource(adSecretBackendLDAPConfigDataSource()),
+			PathInventory: []string{"/ad/config", "/ad/roles", "/ad/library"},
+		},
 		"vault_nomad_access_token": {
 			Resource:      UpdateSchemaResource(nomadAccessCredentialsDataSource()),
 			PathInventory: []string{"/nomad/creds/{role}"},
 		},
+		"vault_terraform_cloud_access_token": {
+			Resource:      UpdateSchemaResource(terraformCloudAccessTokenDataSource()),
+			PathInventory: []string{"/terraform/creds/{role}"},
+		},
 		"vault_aws_access_credentials": {
 			Resource:      UpdateSchemaResource(awsAccessCredentialsDataSource()),
 			PathInventory: []string{"/aws/creds"},
 		},
+		"vault_aws_static_access_credentials": {
+			Resource:      UpdateSchemaResource(awsStaticAccessCredentialsDataSource()),
+			PathInventory: []string{"/aws/static-creds/{name}"},
+		},
 		"vault_azure_access_credentials": {
 			Resource:      UpdateSchemaResource(azureAccessCredentialsDataSource()),
 			PathInventory: []string{"/azure/creds/{role}"},
@@ -266,6 +278,14 @@ var (
 			Resource:      UpdateSchemaResource(kubernetesServiceAccountTokenDataSource()),
 			PathInventory: []string{"/kubernetes/creds/{role}"},
 		},
+		"vault_mongodbatlas_access_credentials": {
+			Resource:      UpdateSchemaResource(mongodbAtlasAccessCredentialsDataSource()),
+			PathInventory: []string{"/mongodbatlas/creds/{name}"},
+		},
+		"vault_alicloud_access_credentials": {
+			Resource:      UpdateSchemaResource(alicloudAccessCredentialsDataSource()),
+			PathInventory: []string{"/alicloud/creds/{name}"},
+		},
 		"vault_generic_secret": {
 			Resource:      UpdateSchemaResource(genericSecretDataSource()),
 			PathInventory: []string{"/secret/data/{path}"},
@@ -286,10 +306,38 @@ var (
 			Resource:      UpdateSchemaResource(transitDecryptDataSource()),
 			PathInventory: []string{"/transit/decrypt/{name}"},
 		},
+		"vault_transit_sign": {
+			Resource:      UpdateSchemaResource(transitSignDataSource()),
+			PathInventory: []string{"/transit/sign/{name}"},
+		},
+		"vault_transit_verify": {
+			Resource:      UpdateSchemaResource(transitVerifyDataSource()),
+			PathInventory: []string{"/transit/verify/{name}"},
+		},
+		"vault_transit_hmac": {
+			Resource:      UpdateSchemaResource(transitHMACDataSource()),
+			PathInventory: []string{"/transit/hmac/{name}"},
+		},
+		"vault_transit_datakey": {
+			Resource:      UpdateSchemaResource(transitDataKeyDataSource()),
+			PathInventory: []string{"/transit/datakey/{plaintext}/{name}"},
+		},
+		"vault_transit_wrapping_key": {
+			Resource:      UpdateSchemaResource(transitWrappingKeyDataSource()),
+			PathInventory: []string{"/transit/wrapping_key"},
+		},
+		"vault_tools_hash": {
+			Resource:      UpdateSchemaResource(toolsHashDataSource()),
+			PathInventory: []string{"/sys/tools/hash/{urlalgorithm}"},
+		},
 		"vault_gcp_auth_backend_role": {
 			Resource:      UpdateSchemaResource(gcpAuthBackendRoleDataSource()),
 			PathInventory: []string{"/auth/gcp/role/{role_name}"},
 		},
+		"vault_gcp_impersonated_account_access_token": {
+			Resource:      UpdateSchemaResource(gcpImpersonatedAccountAccessTokenDataSource()),
+			PathInventory: []string{"/gcp/impersonated-account/{name}/token"},
+		},
 		"vault_identity_oidc_client_creds": {
 			Resource:      UpdateSchemaResource(identityOIDCClientCredsDataSource()),
 			PathInventory: []string{"/identity/oidc/client/{name}"},
@@ -310,6 +358,10 @@ var (
 			Resource:      UpdateSchemaResource(kvSecretV2DataSource()),
 			PathInventory: []string{"/secret/data/{path}/?version={version}}"},
 		},
+		"vault_kv_secrets_v2": {
+			Resource:      UpdateSchemaResource(kvSecretsV2DataSource()),
+			PathInventory: []string{"/secret/data/{path}", "/secret/metadata/{path}/?list=true"},
+		},
 		"vault_kv_secrets_list": {
 			Resource:      UpdateSchemaResource(kvSecretListDataSource()),
 			PathInventory: []string{"/secret/{path}/?list=true"},
@@ -318,13 +370,94 @@ var (
 			Resource:      UpdateSchemaResource(kvSecretListDataSourceV2()),
 			PathInventory: []string{"/secret/metadata/{path}/?list=true"},
 		},
+		"vault_kv_secrets_list_recursive": {
+			Resource:      UpdateSchemaResource(kvSecretListRecursiveDataSource()),
+			PathInventory: []string{"/secret/{path}/?list=true", "/secret/metadata/{path}/?list=true"},
+		},
 		"vault_kv_secret_subkeys_v2": {
 			Resource:      UpdateSchemaResource(kvSecretSubkeysV2DataSource()),
 			PathInventory: []string{"/secret/subkeys/{path}"},
 		},
+		"vault_mounts": {
+			Resource:      UpdateSchemaResource(mountsDataSource()),
+			PathInventory: []string{"/sys/mounts"},
+		},
+		"vault_activity_export": {
+			Resource:      UpdateSchemaResource(activityExportDataSource()),
+			PathInventory: []string{"/sys/internal/counters/activity/export"},
+		},
+		"vault_control_group_request": {
+			Resource:       UpdateSchemaResource(controlGroupRequestDataSource()),
+			PathInventory:  []string{"/sys/control-group/request"},
+			EnterpriseOnly: true,
+		},
+		"vault_license_status": {
+			Resource:       UpdateSchemaResource(licenseStatusDataSource()),
+			PathInventory:  []string{"/sys/license/status", "/sys/utilization-report"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_cert": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCertDataSource()),
+			PathInventory: []string{"/pki/cert/{serial}"},
+		},
+		"vault_pki_secret_backend_cert_metadata": {
+			Resource:       UpdateSchemaResource(pkiSecretBackendCertMetadataDataSource()),
+			PathInventory:  []string{"/pki/cert-metadata/{serial}"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_certs": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCertsDataSource()),
+			PathInventory: []string{"/pki/certs", "/pki/certs/revoked"},
+		},
+		"vault_ssh_certificate": {
+			Resource:      UpdateSchemaResource(sshCertificateDataSource()),
+			PathInventory: []string{"/ssh/sign/{role}"},
+		},
+		"vault_ssh_otp_credential": {
+			Resource:      UpdateSchemaResource(sshOTPCredentialDataSource()),
+			PathInventory: []string{"/ssh/creds/{role}"},
+		},
+		"vault_raft_autopilot_state": {
+			Resource:      UpdateSchemaResource(raftAutopilotStateDataSource()),
+			PathInventory: []string{"/sys/storage/raft/autopilot/state"},
+		},
+		"vault_health": {
+			Resource:      UpdateSchemaResource(healthDataSource()),
+			PathInventory: []string{"/sys/health"},
+		},
+		"vault_seal_status": {
+			Resource:      UpdateSchemaResource(sealStatusDataSource()),
+			PathInventory: []string{"/sys/seal-status"},
+		},
+		"vault_leader": {
+			Resource:      UpdateSchemaResource(leaderDataSource()),
+			PathInventory: []string{"/sys/leader"},
+		},
+		"vault_replication_status": {
+			Resource:       UpdateSchemaResource(replicationStatusDataSource()),
+			PathInventory:  []string{"/sys/replication/status"},
+			EnterpriseOnly: true,
+		},
+		"vault_namespaces": {
+			Resource:       UpdateSchemaResource(namespacesDataSource()),
+			PathInventory:  []string{"/sys/namespaces"},
+			EnterpriseOnly: true,
+		},
+		"vault_plugins": {
+			Resource:      UpdateSchemaResource(pluginsDataSource()),
+			PathInventory: []string{"/sys/plugins/catalog"},
+		},
+		"vault_auth_backends": {
+			Resource:      UpdateSchemaResource(authBackendsDataSource()),
+			PathInventory: []string{"/sys/auth"},
+		},
 	}
 
 	ResourceRegistry = map[string]*Description{
+		"vault_activity_config": {
+			Resource:      UpdateSchemaResource(activityConfigResource()),
+			PathInventory: []string{"/sys/internal/counters/config"},
+		},
 		"vault_alicloud_auth_backend_role": {
 			Resource:      UpdateSchemaResource(alicloudAuthBackendRoleResource()),
 			PathInventory: []string{"/auth/alicloud/role/{name}"},
@@ -412,6 +545,10 @@ var (
 			Resource:      UpdateSchemaResource(awsSecretBackendRoleResource("vault_aws_secret_backend_role")),
 			PathInventory: []string{"/aws/roles/{name}"},
 		},
+		"vault_aws_secret_backend_static_role": {
+			Resource:      UpdateSchemaResource(awsSecretBackendStaticRoleResource()),
+			PathInventory: []string{"/aws/static-roles/{name}"},
+		},
 		"vault_azure_secret_backend": {
 			Resource:      UpdateSchemaResource(azureSecretBackendResource()),
 			PathInventory: []string{"/azure/config"},
@@ -420,6 +557,10 @@ var (
 			Resource:      UpdateSchemaResource(azureSecretBackendRoleResource()),
 			PathInventory: []string{"/azure/roles/{name}"},
 		},
+		"vault_azure_secret_backend_rotate_root": {
+			Resource:      UpdateSchemaResource(azureSecretBackendRotateRootResource()),
+			PathInventory: []string{"/azure/rotate-root"},
+		},
 		"vault_azure_auth_backend_config": {
 			Resource:      UpdateSchemaResource(azureAuthBackendConfigResource()),
 			PathInventory: []string{"/auth/azure/config"},
@@ -448,6 +589,10 @@ var (
 			Resource:      UpdateSchemaResource(databaseSecretBackendRoleResource()),
 			PathInventory: []string{"/database/roles/{name}"},
 		},
+		"vault_database_secret_backend_rotate_root": {
+			Resource:      UpdateSchemaResource(databaseSecretBackendRotateRootResource()),
+			PathInventory: []string{"/database/rotate-root/{name}"},
+		},
 		"vault_database_secret_backend_static_role": {
 			Resource:      UpdateSchemaResource(databaseSecretBackendStaticRoleResource()),
 			PathInventory: []string{"/database/static-roles/{name}"},
@@ -476,6 +621,10 @@ var (
 			Resource:      UpdateSchemaResource(gcpSecretBackendResource("vault_gcp_secret_backend")),
 			PathInventory: []string{"/gcp/config"},
 		},
+		"vault_gcp_secret_backend_rotate_root": {
+			Resource:      UpdateSchemaResource(gcpSecretBackendRotateRootResource()),
+			PathInventory: []string{"/gcp/config/rotate-root"},
+		},
 		"vault_gcp_secret_roleset": {
 			Resource:      UpdateSchemaResource(gcpSecretRolesetResource()),
 			PathInventory: []string{"/gcp/roleset/{name}"},
@@ -484,6 +633,10 @@ var (
 			Resource:      UpdateSchemaResource(gcpSecretStaticAccountResource()),
 			PathInventory: []string{"/gcp/static-account/{name}"},
 		},
+		"vault_gcp_secret_impersonated_account": {
+			Resource:      UpdateSchemaResource(gcpSecretImpersonatedAccountResource()),
+			PathInventory: []string{"/gcp/impersonated-account/{name}"},
+		},
 		"vault_cert_auth_backend_role": {
 			Resource:      UpdateSchemaResource(certAuthBackendRoleResource()),
 			PathInventory: []string{"/auth/cert/certs/{name}"},
@@ -552,6 +705,11 @@ var (
 			Resource:      UpdateSchemaResource(policyResource()),
 			PathInventory: []string{"/sys/policy/{name}"},
 		},
+		"vault_control_group_config": {
+			Resource:       UpdateSchemaResource(controlGroupConfigResource()),
+			PathInventory:  []string{"/sys/config/control-group"},
+			EnterpriseOnly: true,
+		},
 		"vault_egp_policy": {
 			Resource:       UpdateSchemaResource(egpPolicyResource()),
 			PathInventory:  []string{"/sys/policies/egp/{name}"},
@@ -586,6 +744,22 @@ var (
 			Resource:      UpdateSchemaResource(MountResource()),
 			PathInventory: []string{"/sys/mounts/{path}"},
 		},
+		"vault_mount_tune": {
+			Resource:      UpdateSchemaResource(mountTuneResource()),
+			PathInventory: []string{"/sys/mounts/{path}/tune"},
+		},
+		"vault_plugin": {
+			Resource:      UpdateSchemaResource(pluginResource()),
+			PathInventory: []string{"/sys/plugins/catalog/{type}/{name}"},
+		},
+		"vault_plugin_reload": {
+			Resource:      UpdateSchemaResource(pluginReloadResource()),
+			PathInventory: []string{"/sys/plugins/reload/backend"},
+		},
+		"vault_plugin_runtime": {
+			Resource:      UpdateSchemaResource(pluginRuntimeResource()),
+			PathInventory: []string{"/sys/plugins/runtimes/catalog/{type}/{name}"},
+		},
 		"vault_namespace": {
 			Resource:       UpdateSchemaResource(namespaceResource()),
 			PathInventory:  []string{"/sys/namespaces/{path}"},
@@ -662,6 +836,10 @@ var (
 			Resource:      UpdateSchemaResource(passwordPolicyResource()),
 			PathInventory: []string{"/sys/policy/password/{name}"},
 		},
+		"vault_pki_secret_backend_acme_eab": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendACMEEABResource()),
+			PathInventory: []string{"/pki/acme/new-eab", "/pki/eab/{key_id}"},
+		},
 		"vault_pki_secret_backend_cert": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendCertResource()),
 			PathInventory: []string{"/pki/issue/{role}"},
@@ -670,10 +848,36 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendCrlConfigResource()),
 			PathInventory: []string{"/pki/config/crl"},
 		},
+		"vault_pki_secret_backend_config_acme": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendConfigACMEResource()),
+			PathInventory: []string{"/pki/config/acme"},
+		},
+		"vault_pki_secret_backend_config_auto_tidy": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendConfigAutoTidyResource()),
+			PathInventory: []string{"/pki/config/auto-tidy"},
+		},
 		"vault_pki_secret_backend_config_ca": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendConfigCAResource()),
 			PathInventory: []string{"/pki/config/ca"},
 		},
+		"vault_pki_secret_backend_config_cluster": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendConfigClusterResource()),
+			PathInventory: []string{"/pki/config/cluster"},
+		},
+		"vault_pki_secret_backend_config_cmp": {
+			Resource:       UpdateSchemaResource(pkiSecretBackendConfigCMPResource()),
+			PathInventory:  []string{"/pki/config/cmp"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_config_est": {
+			Resource:       UpdateSchemaResource(pkiSecretBackendConfigESTResource()),
+			PathInventory:  []string{"/pki/config/est"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_config_issuers": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendConfigIssuersResource()),
+			PathInventory: []string{"/pki/config/issuers"},
+		},
 		"vault_pki_secret_backend_config_urls": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendConfigUrlsResource()),
 			PathInventory: []string{"/pki/config/urls"},
@@ -686,6 +890,18 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendIntermediateSetSignedResource()),
 			PathInventory: []string{"/pki/intermediate/set-signed"},
 		},
+		"vault_pki_secret_backend_issuer": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendIssuerResource()),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}"},
+		},
+		"vault_pki_secret_backend_issuer_import": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendIssuerImportResource()),
+			PathInventory: []string{"/pki/issuers/import/cert"},
+		},
+		"vault_pki_secret_backend_key": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendKeyResource()),
+			PathInventory: []string{"/pki/keys/generate/{type}", "/pki/keys/import", "/pki/key/{key_ref}"},
+		},
 		"vault_pki_secret_backend_role": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendRoleResource()),
 			PathInventory: []string{"/pki/roles/{name}"},
@@ -702,6 +918,14 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendSignResource()),
 			PathInventory: []string{"/pki/sign/{role}"},
 		},
+		"vault_pki_secret_backend_sign_verbatim": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendSignVerbatimResource()),
+			PathInventory: []string{"/pki/sign-verbatim", "/pki/sign-verbatim/{role}"},
+		},
+		"vault_pki_secret_backend_tidy": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendTidyResource()),
+			PathInventory: []string{"/pki/tidy", "/pki/tidy-status"},
+		},
 		"vault_quota_lease_count": {
 			Resource:      UpdateSchemaResource(quotaLeaseCountResource()),
 			PathInventory: []string{"/sys/quotas/lease-count/{name}"},
@@ -726,10 +950,58 @@ var (
 			Resource:      UpdateSchemaResource(transitSecretBackendKeyResource()),
 			PathInventory: []string{"/transit/keys/{name}"},
 		},
+		"vault_transit_secret_backend_key_import": {
+			Resource: UpdateSchemaResource(transitSecretBackendKeyImportResource()),
+			PathInventory: []string{
+				"/transit/keys/{name}/import",
+				"/transit/keys/{name}/import_version",
+			},
+		},
+		"vault_transit_secret_backend_key_backup": {
+			Resource:      UpdateSchemaResource(transitSecretBackendKeyBackupResource()),
+			PathInventory: []string{"/transit/backup/{name}"},
+		},
+		"vault_transit_secret_backend_key_restore": {
+			Resource:      UpdateSchemaResource(transitSecretBackendKeyRestoreResource()),
+			PathInventory: []string{"/transit/restore/{name}"},
+		},
+		"vault_transit_secret_backend_key_csr": {
+			Resource:      UpdateSchemaResource(transitSecretBackendKeyCSRResource()),
+			PathInventory: []string{"/transit/keys/{name}/csr"},
+		},
+		"vault_transit_secret_backend_key_certificate": {
+			Resource:      UpdateSchemaResource(transitSecretBackendKeyCertificateResource()),
+			PathInventory: []string{"/transit/keys/{name}/set-certificate"},
+		},
+		"vault_transit_rewrap": {
+			Resource:      UpdateSchemaResource(transitRewrapResource()),
+			PathInventory: []string{"/transit/rewrap/{name}"},
+		},
 		"vault_transit_secret_cache_config": {
 			Resource:      UpdateSchemaResource(transitSecretBackendCacheConfig()),
 			PathInventory: []string{"/transit/cache-config"},
 		},
+		"vault_key_rotation": {
+			Resource:      UpdateSchemaResource(keyRotationResource()),
+			PathInventory: []string{"/sys/rotate"},
+		},
+		"vault_tools_random": {
+			Resource:      UpdateSchemaResource(toolsRandomResource()),
+			PathInventory: []string{"/sys/tools/random/{source}/{urlbytes}"},
+		},
+		"vault_key_rotation_config": {
+			Resource:      UpdateSchemaResource(keyRotationConfigResource()),
+			PathInventory: []string{"/sys/rotate/config"},
+		},
+		"vault_sealwrap_rewrap": {
+			Resource:       UpdateSchemaResource(sealWrapRewrapResource()),
+			PathInventory:  []string{"/sys/sealwrap/rewrap"},
+			EnterpriseOnly: true,
+		},
+		"vault_raft_snapshot": {
+			Resource:      UpdateSchemaResource(raftSnapshotResource()),
+			PathInventory: []string{"/sys/storage/raft/snapshot"},
+		},
 		"vault_raft_snapshot_agent_config": {
 			Resource:      UpdateSchemaResource(raftSnapshotAgentConfigResource()),
 			PathInventory: []string{"/sys/storage/raft/snapshot-auto/config/{name}"},
@@ -738,6 +1010,19 @@ var (
 			Resource:      UpdateSchemaResource(raftAutopilotConfigResource()),
 			PathInventory: []string{"/sys/storage/raft/autopilot/configuration"},
 		},
+		"vault_replication_performance_secondary_token": {
+			Resource: UpdateSchemaResource(replicationPerformanceSecondaryTokenResource()),
+			PathInventory: []string{
+				"/sys/replication/performance/primary/secondary-token",
+				"/sys/replication/performance/primary/revoke-secondary",
+			},
+			EnterpriseOnly: true,
+		},
+		"vault_replication_performance_paths_filter": {
+			Resource:       UpdateSchemaResource(replicationPerformancePathsFilterResource()),
+			PathInventory:  []string{"/sys/replication/performance/primary/paths-filter/{id}"},
+			EnterpriseOnly: true,
+		},
 		"vault_kmip_secret_backend": {
 			Resource:      UpdateSchemaResource(kmipSecretBackendResource()),
 			PathInventory: []string{"/kmip/config"},
@@ -750,6 +1035,14 @@ var (
 			Resource:      UpdateSchemaResource(kmipSecretRoleResource()),
 			PathInventory: []string{"/kmip/scope/{scope}/role/{role}"},
 		},
+		"vault_kmip_secret_credential": {
+			Resource: UpdateSchemaResource(kmipSecretCredentialResource()),
+			PathInventory: []string{
+				"/kmip/scope/{scope}/role/{role}/credential/generate",
+				"/kmip/scope/{scope}/role/{role}/credential/lookup",
+				"/kmip/scope/{scope}/role/{role}/credential/revoke",
+			},
+		},
 		"vault_identity_oidc_scope": {
 			Resource:      UpdateSchemaResource(identityOIDCScopeResource()),
 			PathInventory: []string{"/identity/oidc/scope/{scope}"},
@@ -778,6 +1071,18 @@ var (
 			Resource:      UpdateSchemaResource(kvSecretV2Resource("vault_kv_secret_v2")),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_kv_secret_v2_metadata": {
+			Resource:      UpdateSchemaResource(kvSecretV2MetadataResource()),
+			PathInventory: []string{"/secret/metadata/{path}"},
+		},
+		"vault_kv_secret_v2_versions": {
+			Resource: UpdateSchemaResource(kvSecretV2VersionsResource()),
+			PathInventory: []string{
+				"/secret/delete/{path}",
+				"/secret/undelete/{path}",
+				"/secret/destroy/{path}",
+			},
+		},
 		"vault_kubernetes_secret_backend": {
 			Resource:      UpdateSchemaResource(kubernetesSecretBackendResource()),
 			PathInventory: []string{"/kubernetes/config"},
@@ -786,10 +1091,55 @@ var (
 			Resource:      UpdateSchemaResource(kubernetesSecretBackendRoleResource()),
 			PathInventory: []string{"/kubernetes/roles/{name}"},
 		},
+		"vault_mongodbatlas_secret_backend": {
+			Resource:      UpdateSchemaResource(mongodbAtlasSecretBackendResource()),
+			PathInventory: []string{"/mongodbatlas/config"},
+		},
+		"vault_mongodbatlas_secret_backend_role": {
+			Resource:      UpdateSchemaResource(mongodbAtlasSecretBackendRoleResource()),
+			PathInventory: []string{"/mongodbatlas/roles/{name}"},
+		},
+		"vault_ldap_secret_backend": {
+			Resource:      UpdateSchemaResource(ldapSecretBackendResource()),
+			PathInventory: []string{"/ldap/config"},
+		},
+		"vault_ldap_secret_backend_static_role": {
+			Resource:      UpdateSchemaResource(ldapSecretBackendStaticRoleResource()),
+			PathInventory: []string{"/ldap/static-role/{name}"},
+		},
+		"vault_ldap_secret_backend_dynamic_role": {
+			Resource:      UpdateSchemaResource(ldapSecretBackendDynamicRoleResource()),
+			PathInventory: []string{"/ldap/role/{name}"},
+		},
+		"vault_ldap_secret_backend_library_set": {
+			Resource:      UpdateSchemaResource(ldapSecretBackendLibrarySetResource()),
+			PathInventory: []string{"/ldap/library/{name}"},
+		},
+		"vault_alicloud_secret_backend": {
+			Resource:      UpdateSchemaResource(alicloudSecretBackendResource()),
+			PathInventory: []string{"/alicloud/config"},
+		},
+		"vault_alicloud_secret_backend_role": {
+			Resource:      UpdateSchemaResource(alicloudSecretBackendRoleResource()),
+			PathInventory: []string{"/alicloud/role/{name}"},
+		},
 		"vault_managed_keys": {
 			Resource:      UpdateSchemaResource(managedKeysResource()),
 			PathInventory: []string{"/sys/managed-keys/{type}/{name}"},
 		},
+		"vault_ui_custom_message": {
+			Resource: UpdateSchemaResource(uiCustomMessageResource()),
+			PathInventory: []string{
+				"/sys/config/ui/custom-messages",
+				"/sys/config/ui/custom-messages/{id}",
+			},
+			EnterpriseOnly: true,
+		},
+		"vault_ui_login_default_auth": {
+			Resource:       UpdateSchemaResource(uiLoginDefaultAuthResource()),
+			PathInventory:  []string{"/sys/config/ui/login/default-auth/{name}"},
+			EnterpriseOnly: true,
+		},
 	}
 )
 
@@ -847,6 +1197,25 @@ func MountCreateContextWrapper(f schema.CreateContextFunc, minVersion *version.V
 	}
 }
 
+// DeletionProtectionWrapper refuses to call the wrapped schema.DeleteFunc
+// while deletion protection is enabled on the resource.
+func DeletionProtectionWrapper(f schema.DeleteFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if d.Get(consts.FieldDeletionProtection).(bool) {
+			return fmt.Errorf("deletion protection is enabled for %q, "+
+				"set %s to false and apply before destroying", d.Id(), consts.FieldDeletionProtection)
+		}
+
+		return f(d, meta)
+	}
+}
+
+// setDeletionProtection ensures that deletion_protection is tracked in the
+// state, it is not stored in Vault so it is false after an import.
+func setDeletionProtection(d *schema.ResourceData) error {
+	return d.Set(consts.FieldDeletionProtection, d.Get(consts.FieldDeletionProtection))
+}
+
 func importNamespace(d *schema.ResourceData) error {
 	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
 		s := d.State()
@@ -0,0 +1,141 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var (
+	activityConfigPath     = "sys/internal/counters/config"
+	activityConfigDefaults = map[string]interface{}{
+		consts.FieldEnabled:         "default",
+		consts.FieldRetentionMonths: 48,
+	}
+)
+
+func activityConfigResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: activityConfigWrite,
+		ReadContext:   ReadContextWrapper(activityConfigRead),
+		UpdateContext: activityConfigWrite,
+		DeleteContext: activityConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldEnabled: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  activityConfigDefaults[consts.FieldEnabled],
+				Description: "Enable or disable the collection of client count data, " +
+					"one of 'enable', 'disable' or 'default'.",
+				ValidateFunc: validation.StringInSlice([]string{"enable", "disable", "default"}, false),
+			},
+			consts.FieldRetentionMonths: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The number of months of client count data to retain.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			consts.FieldReportingEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether automated license reporting is enabled.",
+			},
+			consts.FieldBillingStartTimestamp: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start of the current billing period, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func activityConfigWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldEnabled: d.Get(consts.FieldEnabled),
+	}
+	if v, ok := d.GetOk(consts.FieldRetentionMonths); ok {
+		data[consts.FieldRetentionMonths] = v
+	}
+
+	log.Printf("[DEBUG] Configuring activity log")
+	if _, err := client.Logical().Write(activityConfigPath, data); err != nil {
+		return diag.Errorf("error writing %q: %s", activityConfigPath, err)
+	}
+	log.Printf("[DEBUG] Configured activity log")
+
+	d.SetId(activityConfigPath)
+
+	return activityConfigRead(ctx, d, meta)
+}
+
+func activityConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading %q", activityConfigPath)
+	resp, err := client.Logical().Read(activityConfigPath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", activityConfigPath, err)
+	}
+
+	if resp == nil {
+		return diag.Errorf("no activity log configuration found at %q", activityConfigPath)
+	}
+
+	// Vault reports the effective value of the default setting,
+	// e.g. "default-enabled".
+	if v, ok := resp.Data[consts.FieldEnabled].(string); ok {
+		if strings.HasPrefix(v, "default") {
+			v = "default"
+		}
+		if err := d.Set(consts.FieldEnabled, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, k := range []string{
+		consts.FieldRetentionMonths,
+		consts.FieldReportingEnabled,
+		consts.FieldBillingStartTimestamp,
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on activity config, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func activityConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Resetting activity log config")
+	if _, err := client.Logical().Write(activityConfigPath, activityConfigDefaults); err != nil {
+		return diag.Errorf("error resetting activity log config: %s", err)
+	}
+	log.Printf("[DEBUG] Reset activity log config")
+
+	return nil
+}
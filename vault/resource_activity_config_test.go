@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestResourceActivityConfig(t *testing.T) {
+	resourceName := "vault_activity_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testActivityConfig("enable", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "enable"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRetentionMonths, "60"),
+				),
+			},
+			{
+				Config: testActivityConfig("disable", 72),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "disable"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRetentionMonths, "72"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testActivityConfig(enabled string, retentionMonths int) string {
+	return fmt.Sprintf(`
+resource "vault_activity_config" "test" {
+  enabled          = "%s"
+  retention_months = %d
+}
+`, enabled, retentionMonths)
+}
@@ -0,0 +1,131 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func alicloudSecretBackendResource() *schema.Resource {
+	resource := &schema.Resource{
+		CreateContext: alicloudSecretBackendCreateUpdate,
+		ReadContext:   ReadContextWrapper(alicloudSecretBackendRead),
+		UpdateContext: alicloudSecretBackendCreateUpdate,
+		DeleteContext: alicloudSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldAccessKey: {
+				Type:        schema.TypeString,
+				Description: "The ID of the AliCloud access key used by Vault to manage credentials.",
+				Required:    true,
+			},
+			consts.FieldSecretKey: {
+				Type:        schema.TypeString,
+				Description: "The secret of the AliCloud access key used by Vault to manage credentials.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+
+	// Add common mount schema to the resource
+	provider.MustAddSchema(resource, getMountSchema("type"))
+
+	return resource
+}
+
+func alicloudSecretBackendCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var path string
+	if d.IsNewResource() {
+		path = d.Get(consts.FieldPath).(string)
+		if err := createMount(d, client, path, consts.MountTypeAliCloud); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := updateMount(d, meta, true); err != nil {
+			return diag.FromErr(err)
+		}
+		path = d.Id()
+	}
+	d.SetId(path)
+
+	// the access key pair must always be provided together on configuration updates.
+	data := map[string]interface{}{
+		consts.FieldAccessKey: d.Get(consts.FieldAccessKey),
+		consts.FieldSecretKey: d.Get(consts.FieldSecretKey),
+	}
+
+	configPath := fmt.Sprintf("%s/config", path)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return diag.Errorf(`error writing AliCloud backend config %q, err=%s`,
+			configPath, err)
+	}
+
+	return alicloudSecretBackendRead(ctx, d, meta)
+}
+
+func alicloudSecretBackendRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	resp, err := client.Logical().Read(path + "/config")
+	if err != nil {
+		return diag.Errorf("error reading AliCloud backend at %s/config: err=%s",
+			path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] AliCloud config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	// consts.FieldSecretKey can't be read from the API
+	if v, ok := resp.Data[consts.FieldAccessKey]; ok {
+		if err := d.Set(consts.FieldAccessKey, v); err != nil {
+			return diag.Errorf("error setting state key %q on AliCloud backend config, err=%s",
+				consts.FieldAccessKey, err)
+		}
+	}
+
+	if err := readMount(d, meta, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func alicloudSecretBackendDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	if err := client.Sys().Unmount(path); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", path)
+			d.SetId("")
+		}
+		return diag.Errorf("error unmounting AliCloud backend from %q, err=%s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted AliCloud backend at %q", path)
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var alicloudSecretBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+
+const (
+	fieldAliCloudRemotePolicies = "remote_policies"
+	fieldAliCloudInlinePolicies = "inline_policies"
+)
+
+var alicloudSecretBackendRoleTTLFields = []string{
+	consts.FieldTTL,
+	consts.FieldMaxTTL,
+}
+
+func alicloudSecretBackendRoleResource() *schema.Resource {
+	credentialFields := []string{
+		consts.FieldRoleArn,
+		fieldAliCloudRemotePolicies,
+		fieldAliCloudInlinePolicies,
+	}
+
+	return &schema.Resource{
+		CreateContext: alicloudSecretBackendRoleCreateUpdate,
+		ReadContext:   ReadContextWrapper(alicloudSecretBackendRoleRead),
+		UpdateContext: alicloudSecretBackendRoleCreateUpdate,
+		DeleteContext: alicloudSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Description: "The name of the role.",
+				ForceNew:    true,
+				Required:    true,
+			},
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Description: "The mount path for the AliCloud secrets engine.",
+				Required:    true,
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldRoleArn: {
+				Type: schema.TypeString,
+				Description: "The ARN of the AliCloud RAM role to assume to generate STS credentials. " +
+					"Conflicts with remote_policies and inline_policies.",
+				Optional:      true,
+				ConflictsWith: []string{fieldAliCloudRemotePolicies, fieldAliCloudInlinePolicies},
+				AtLeastOneOf:  credentialFields,
+			},
+			fieldAliCloudRemotePolicies: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^name:[^,]+,type:[^,]+$"), "must be of the form name:<name>,type:<type>"),
+				},
+				Description: "The existing AliCloud policies to attach to the RAM users generated by Vault, " +
+					"in the form name:<name>,type:<type>.",
+				Optional:     true,
+				AtLeastOneOf: credentialFields,
+			},
+			fieldAliCloudInlinePolicies: {
+				Type:             schema.TypeString,
+				Description:      "A JSON encoded list of policy documents to attach to the RAM users generated by Vault.",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: util.JsonDiffSuppress,
+				AtLeastOneOf:     credentialFields,
+			},
+			consts.FieldTTL: {
+				Type:        schema.TypeInt,
+				Description: "The TTL of the generated credentials in seconds.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldMaxTTL: {
+				Type:        schema.TypeInt,
+				Description: "The maximum TTL of the generated credentials in seconds.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func alicloudSecretBackendRoleCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldRoleArn:         d.Get(consts.FieldRoleArn),
+		fieldAliCloudRemotePolicies: d.Get(fieldAliCloudRemotePolicies).(*schema.Set).List(),
+		fieldAliCloudInlinePolicies: d.Get(fieldAliCloudInlinePolicies),
+	}
+	for _, k := range alicloudSecretBackendRoleTTLFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	name := d.Get(consts.FieldName).(string)
+	backend := d.Get(consts.FieldBackend).(string)
+	rolePath := alicloudSecretBackendRolePath(backend, name)
+	if _, err := client.Logical().Write(rolePath, data); err != nil {
+		return diag.Errorf(`error writing AliCloud backend role %q, err=%s`,
+			rolePath, err)
+	}
+
+	d.SetId(rolePath)
+	return alicloudSecretBackendRoleRead(ctx, d, meta)
+}
+
+func alicloudSecretBackendRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading AliCloud backend role at %s: err=%s",
+			path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] AliCloud backend role not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	backend, err := alicloudSecretBackendFromPath(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldBackend, backend); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldName, path[strings.LastIndex(path, "/")+1:]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldRoleArn, resp.Data[consts.FieldRoleArn]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// remote policies are returned as objects, and are flattened back
+	// to the name:<name>,type:<type> form they are configured with.
+	var remotePolicies []string
+	if v, ok := resp.Data[fieldAliCloudRemotePolicies].([]interface{}); ok {
+		for _, raw := range v {
+			policy, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			remotePolicies = append(remotePolicies,
+				fmt.Sprintf("name:%s,type:%s", policy["name"], policy["type"]))
+		}
+	}
+	if err := d.Set(fieldAliCloudRemotePolicies, remotePolicies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// inline policies are returned along with their hash, only the
+	// policy documents are kept.
+	inlinePolicies := ""
+	if v, ok := resp.Data[fieldAliCloudInlinePolicies].([]interface{}); ok && len(v) > 0 {
+		var documents []interface{}
+		for _, raw := range v {
+			policy, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			documents = append(documents, policy["policy_document"])
+		}
+		b, err := json.Marshal(documents)
+		if err != nil {
+			return diag.Errorf("error encoding %q of %q: %s", fieldAliCloudInlinePolicies, path, err)
+		}
+		inlinePolicies = string(b)
+	}
+	if err := d.Set(fieldAliCloudInlinePolicies, inlinePolicies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range alicloudSecretBackendRoleTTLFields {
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err := v.Int64()
+			if err != nil {
+				return diag.Errorf("unexpected value %q for %s of %q", v, k, path)
+			}
+			if err := d.Set(k, n); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func alicloudSecretBackendRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting AliCloud backend role at %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func alicloudSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + name
+}
+
+func alicloudSecretBackendFromPath(path string) (string, error) {
+	if !alicloudSecretBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := alicloudSecretBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
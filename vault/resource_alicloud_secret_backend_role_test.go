@@ -0,0 +1,143 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccAliCloudSecretBackendRole(t *testing.T) {
+	resourceName := "vault_alicloud_secret_backend_role.test"
+	backend := acctest.RandomWithPrefix("tf-test-alicloud")
+	name := acctest.RandomWithPrefix("tf-test-role")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccAliCloudSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAliCloudSecretBackendRoleConfig(backend, name, `
+  remote_policies = ["name:AliyunOSSReadOnlyAccess,type:System"]
+  inline_policies = jsonencode([{
+    Version = "1"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["rds:Describe*"]
+      Resource = ["acs:rds:*"]
+    }]
+  }])
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleArn, ""),
+					resource.TestCheckResourceAttr(resourceName, fieldAliCloudRemotePolicies+".#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, fieldAliCloudRemotePolicies+".*", "name:AliyunOSSReadOnlyAccess,type:System"),
+					resource.TestCheckResourceAttrSet(resourceName, fieldAliCloudInlinePolicies),
+				),
+			},
+			{
+				Config: testAliCloudSecretBackendRoleConfig(backend, name, `
+  remote_policies = [
+    "name:AliyunOSSReadOnlyAccess,type:System",
+    "name:AliyunRDSReadOnlyAccess,type:System",
+  ]
+  ttl     = 3600
+  max_ttl = 7200
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, fieldAliCloudRemotePolicies+".#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, fieldAliCloudRemotePolicies+".*", "name:AliyunOSSReadOnlyAccess,type:System"),
+					resource.TestCheckTypeSetElemAttr(resourceName, fieldAliCloudRemotePolicies+".*", "name:AliyunRDSReadOnlyAccess,type:System"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "7200"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+			{
+				Config: testAliCloudSecretBackendRoleConfig(backend, name, `
+  role_arn        = "acs:ram::5138828231865461:role/hastrustedactors"
+  remote_policies = ["name:AliyunOSSReadOnlyAccess,type:System"]
+`),
+				ExpectError: regexp.MustCompile(`"role_arn": conflicts with remote_policies`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func TestAccAliCloudSecretBackendRole_roleARN(t *testing.T) {
+	resourceName := "vault_alicloud_secret_backend_role.test"
+	backend := acctest.RandomWithPrefix("tf-test-alicloud")
+	name := acctest.RandomWithPrefix("tf-test-role")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccAliCloudSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAliCloudSecretBackendRoleConfig(backend, name, `
+  role_arn = "acs:ram::5138828231865461:role/hastrustedactors"
+  ttl      = 900
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleArn, "acs:ram::5138828231865461:role/hastrustedactors"),
+					resource.TestCheckResourceAttr(resourceName, fieldAliCloudRemotePolicies+".#", "0"),
+					resource.TestCheckResourceAttr(resourceName, fieldAliCloudInlinePolicies, ""),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTTL, "900"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccAliCloudSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_alicloud_secret_backend_role" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for AliCloud secret backend role %q: %s",
+				rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("AliCloud secret backend role %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAliCloudSecretBackendRoleConfig(backend, name, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path       = "%s"
+  access_key = "LTAI5tAccessKey"
+  secret_key = "secret"
+}
+
+resource "vault_alicloud_secret_backend_role" "test" {
+  backend = vault_alicloud_secret_backend.test.path
+  name    = "%s"
+%s
+}
+`, backend, name, extra)
+}
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccAliCloudSecretBackend(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-alicloud")
+	resourceType := "vault_alicloud_secret_backend"
+	resourceName := resourceType + ".test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed(resourceType, consts.MountTypeAliCloud, ""),
+		Steps: []resource.TestStep{
+			{
+				Config: testAliCloudSecretBackend_initialConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultLeaseTTL, "0"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxLeaseTTL, "0"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAccessKey, "LTAI5tAccessKey"),
+				),
+			},
+			{
+				Config: testAliCloudSecretBackend_updateConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDescription, "alicloud secrets engine"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultLeaseTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxLeaseTTL, "7200"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAccessKey, "LTAI5tOtherAccessKey"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{consts.FieldSecretKey},
+			},
+		},
+	})
+}
+
+func testAliCloudSecretBackend_initialConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path       = "%s"
+  access_key = "LTAI5tAccessKey"
+  secret_key = "secret"
+}`, path)
+}
+
+func testAliCloudSecretBackend_updateConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path                      = "%s"
+  description               = "alicloud secrets engine"
+  default_lease_ttl_seconds = "3600"
+  max_lease_ttl_seconds     = "7200"
+  access_key                = "LTAI5tOtherAccessKey"
+  secret_key                = "other-secret"
+}`, path)
+}
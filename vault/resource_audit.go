@@ -12,10 +12,11 @@ import (
 )
 
 func auditResource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddDeletionProtectionSchema(&schema.Resource{
 		Create: auditWrite,
 		Read:   ReadWrapper(auditRead),
-		Delete: auditDelete,
+		Update: auditUpdate,
+		Delete: DeletionProtectionWrapper(auditDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -54,7 +55,7 @@ func auditResource() *schema.Resource {
 				Description: "Configuration options to pass to the audit device itself.",
 			},
 		},
-	}
+	})
 }
 
 func auditWrite(d *schema.ResourceData, meta interface{}) error {
@@ -95,6 +96,12 @@ func auditWrite(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// auditUpdate only handles deletion_protection, all other fields force a
+// new audit device.
+func auditUpdate(d *schema.ResourceData, meta interface{}) error {
+	return auditRead(d, meta)
+}
+
 func auditDelete(d *schema.ResourceData, meta interface{}) error {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -143,5 +150,9 @@ func auditRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", audit.Description)
 	d.Set("options", audit.Options)
 
+	if err := setDeletionProtection(d); err != nil {
+		return err
+	}
+
 	return nil
 }
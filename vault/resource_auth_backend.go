@@ -13,11 +13,11 @@ import (
 )
 
 func AuthBackendResource() *schema.Resource {
-	return provider.MustAddMountMigrationSchema(&schema.Resource{
+	return provider.MustAddDeletionProtectionSchema(provider.MustAddMountMigrationSchema(&schema.Resource{
 		SchemaVersion: 1,
 
 		Create: authBackendWrite,
-		Delete: authBackendDelete,
+		Delete: DeletionProtectionWrapper(authBackendDelete),
 		Read:   ReadWrapper(authBackendRead),
 		Update: authBackendUpdate,
 		Importer: &schema.ResourceImporter{
@@ -66,7 +66,7 @@ func AuthBackendResource() *schema.Resource {
 
 			"tune": authMountTuneSchema(),
 		},
-	})
+	}))
 }
 
 func authBackendWrite(d *schema.ResourceData, meta interface{}) error {
@@ -149,6 +149,10 @@ func authBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := setDeletionProtection(d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
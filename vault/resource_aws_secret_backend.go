@@ -2,6 +2,7 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -95,10 +96,34 @@ func awsSecretBackendResource() *schema.Resource {
 				Computed:    true,
 				Description: "Template describing how dynamic usernames are generated.",
 			},
+			consts.FieldIdentityTokenAudience: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of the plugin identity token. Requires Vault Enterprise 1.16 or later.",
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of the plugin identity token in seconds. Requires Vault Enterprise 1.16 or later.",
+			},
+			consts.FieldRoleArn: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ARN of the AWS role to assume with the plugin identity token. Requires Vault Enterprise 1.16 or later.",
+			},
 		},
 	})
 }
 
+// awsSecretBackendWIFFields are the config/root fields used to authenticate
+// to AWS with plugin workload identity federation.
+var awsSecretBackendWIFFields = []string{
+	consts.FieldIdentityTokenAudience,
+	consts.FieldIdentityTokenTTL,
+	consts.FieldRoleArn,
+}
+
 func getMountCustomizeDiffFunc(field string) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 		if !diff.HasChange(field) {
@@ -174,6 +199,14 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if usernameTemplate != "" {
 		data["username_template"] = usernameTemplate
 	}
+	for _, k := range awsSecretBackendWIFFields {
+		if v, ok := d.GetOk(k); ok {
+			if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+				return fmt.Errorf("%s requires Vault 1.16 or later", k)
+			}
+			data[k] = v
+		}
+	}
 	_, err = client.Logical().Write(path+"/config/root", data)
 	if err != nil {
 		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -250,6 +283,18 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := resp.Data["username_template"].(string); ok {
 			d.Set("username_template", v)
 		}
+		for _, k := range []string{consts.FieldIdentityTokenAudience, consts.FieldRoleArn} {
+			if v, ok := resp.Data[k].(string); ok {
+				d.Set(k, v)
+			}
+		}
+		if v, ok := resp.Data[consts.FieldIdentityTokenTTL].(json.Number); ok {
+			ttl, err := v.Int64()
+			if err != nil {
+				return fmt.Errorf("unexpected value %q for %s of %q: %s", v, consts.FieldIdentityTokenTTL, path, err)
+			}
+			d.Set(consts.FieldIdentityTokenTTL, ttl)
+		}
 	}
 
 	d.Set(consts.FieldPath, path)
@@ -286,7 +331,7 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
 	}
-	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") {
+	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") || d.HasChanges(awsSecretBackendWIFFields...) {
 		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
 		data := map[string]interface{}{
 			"access_key": d.Get("access_key").(string),
@@ -309,6 +354,14 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if usernameTemplate != "" {
 			data["username_template"] = usernameTemplate
 		}
+		for _, k := range awsSecretBackendWIFFields {
+			if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+				if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+					return fmt.Errorf("%s requires Vault 1.16 or later", k)
+				}
+				data[k] = v
+			}
+		}
 		_, err := client.Logical().Write(path+"/config/root", data)
 		if err != nil {
 			return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -95,6 +95,27 @@ func awsSecretBackendRoleResource(name string) *schema.Resource {
 				Optional:    true,
 				Description: "The path for the user name. Valid only when credential_type is iam_user. Default is /",
 			},
+			"iam_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A map of strings representing key/value pairs used as tags for any IAM user created by this role. Valid only when credential_type is iam_user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"session_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A map of strings representing key/value pairs to be passed as session tags to the AssumeRole call. Valid only when credential_type is assumed_role. Requires Vault 1.16 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The external ID to pass to the AssumeRole call. Valid only when credential_type is assumed_role. Requires Vault 1.16 or later.",
+			},
 		},
 	}
 }
@@ -159,6 +180,26 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("user_path is only valid when credential_type is iam_user")
 		}
 	}
+	if d.HasChange("iam_tags") {
+		if credentialType == "iam_user" {
+			data["iam_tags"] = d.Get("iam_tags")
+		} else {
+			return fmt.Errorf("iam_tags is only valid when credential_type is iam_user")
+		}
+	}
+
+	for _, k := range []string{"session_tags", "external_id"} {
+		if !d.HasChange(k) {
+			continue
+		}
+		if credentialType != "assumed_role" {
+			return fmt.Errorf("%s is only valid when credential_type is assumed_role", k)
+		}
+		if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+			return fmt.Errorf("%s requires Vault 1.16 or later", k)
+		}
+		data[k] = d.Get(k)
+	}
 
 	defaultStsTTL, defaultStsTTLOk := d.GetOk("default_sts_ttl")
 	maxStsTTL, maxStsTTLOk := d.GetOk("max_sts_ttl")
@@ -242,6 +283,11 @@ func awsSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := secret.Data["user_path"]; ok {
 		d.Set("user_path", v)
 	}
+	for _, k := range []string{"iam_tags", "session_tags", "external_id"} {
+		if v, ok := secret.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
 
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
@@ -114,6 +114,51 @@ func TestAccAWSSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackendRole_tags(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := testutil.GetTestAWSCreds(t)
+	iamUserResource := "vault_aws_secret_backend_role.test_iam_user"
+	assumedRoleResource := "vault_aws_secret_backend_role.test_assumed_role"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("session_tags and external_id require Vault 1.16 or later")
+			}
+		},
+		CheckDestroy: testAccAWSSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendRoleConfig_tags(name, backend, accessKey, secretKey, "engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(iamUserResource, "iam_tags.%", "2"),
+					resource.TestCheckResourceAttr(iamUserResource, "iam_tags.team", "engineering"),
+					resource.TestCheckResourceAttr(iamUserResource, "iam_tags.env", "test"),
+					resource.TestCheckResourceAttr(assumedRoleResource, "session_tags.%", "2"),
+					resource.TestCheckResourceAttr(assumedRoleResource, "session_tags.team", "engineering"),
+					resource.TestCheckResourceAttr(assumedRoleResource, "session_tags.env", "test"),
+					resource.TestCheckResourceAttr(assumedRoleResource, "external_id", "engineering-id"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_tags(name, backend, accessKey, secretKey, "platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(iamUserResource, "iam_tags.team", "platform"),
+					resource.TestCheckResourceAttr(assumedRoleResource, "session_tags.team", "platform"),
+					resource.TestCheckResourceAttr(assumedRoleResource, "external_id", "platform-id"),
+				),
+			},
+			{
+				ResourceName:      assumedRoleResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_aws_secret_backend_role" {
@@ -362,3 +407,37 @@ resource "vault_aws_secret_backend_role" "test_iam_user_type_optional_attributes
 	}
 	return strings.Join(resources, "\n")
 }
+
+func testAccAWSSecretBackendRoleConfig_tags(name, path, accessKey, secretKey, team string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "test_iam_user" {
+  name = "%s-iam-user"
+  policy_arns = ["%s"]
+  credential_type = "iam_user"
+  backend = vault_aws_secret_backend.test.path
+  iam_tags = {
+    team = "%s"
+    env  = "test"
+  }
+}
+
+resource "vault_aws_secret_backend_role" "test_assumed_role" {
+  name = "%s-assumed-role"
+  role_arns = ["%s"]
+  credential_type = "assumed_role"
+  backend = vault_aws_secret_backend.test.path
+  external_id = "%s-id"
+  session_tags = {
+    team = "%s"
+    env  = "test"
+  }
+}
+`, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyArn_basic, team,
+		name, testAccAWSSecretBackendRoleRoleArn_basic, team, team)
+}
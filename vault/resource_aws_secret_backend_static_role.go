@@ -0,0 +1,148 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var awsSecretBackendStaticRolePathRegex = regexp.MustCompile("^(.+)/static-roles/(.+)$")
+
+func awsSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(awsSecretBackendStaticRoleWrite, provider.VaultVersion114),
+		ReadContext:   ReadContextWrapper(awsSecretBackendStaticRoleRead),
+		UpdateContext: awsSecretBackendStaticRoleWrite,
+		DeleteContext: awsSecretBackendStaticRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the AWS Secret Backend the static role belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the static role.",
+			},
+			consts.FieldUsername: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the existing AWS IAM user to manage the credentials of.",
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "How often Vault should rotate the access key of the user, in seconds.",
+			},
+		},
+	}
+}
+
+func awsSecretBackendStaticRoleWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := awsSecretBackendStaticRolePath(d.Get(consts.FieldBackend).(string), d.Get(consts.FieldName).(string))
+	data := map[string]interface{}{
+		consts.FieldUsername: d.Get(consts.FieldUsername),
+		"rotation_period":    d.Get("rotation_period"),
+	}
+
+	log.Printf("[DEBUG] Writing AWS static role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing AWS static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AWS static role %q", path)
+
+	d.SetId(path)
+
+	return awsSecretBackendStaticRoleRead(ctx, d, meta)
+}
+
+func awsSecretBackendStaticRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	res := awsSecretBackendStaticRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return diag.Errorf("invalid AWS static role ID %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading AWS static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading AWS static role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] AWS static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, res[1]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldName, res[2]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldUsername, resp.Data[consts.FieldUsername]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := resp.Data["rotation_period"].(json.Number); ok {
+		n, err := v.Float64()
+		if err != nil {
+			return diag.Errorf("unexpected value %q for rotation_period of %q", v, path)
+		}
+		if err := d.Set("rotation_period", int(n)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func awsSecretBackendStaticRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting AWS static role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting AWS static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AWS static role %q", path)
+
+	return nil
+}
+
+func awsSecretBackendStaticRolePath(backend, name string) string {
+	return fmt.Sprintf("%s/static-roles/%s", strings.Trim(backend, "/"), name)
+}
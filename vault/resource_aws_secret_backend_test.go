@@ -91,6 +91,40 @@ func TestAccAWSSecretBackend_usernameTempl(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackend_wif(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	resourceType := "vault_aws_secret_backend"
+	resourceName := resourceType + ".test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("plugin workload identity requires Vault 1.16 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed(resourceType, consts.MountTypeAWS, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendConfig_wif(path, "vault-aws-audience", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-aws-audience"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleArn, "arn:aws:iam::123456789123:role/vault"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendConfig_wif(path, "vault-aws-audience-updated", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-aws-audience-updated"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "1800"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil, "disable_remount"),
+		},
+	})
+}
+
 func TestAccAWSSecretBackend_remount(t *testing.T) {
 	path := acctest.RandomWithPrefix("tf-test-aws")
 	updatedPath := acctest.RandomWithPrefix("tf-test-aws-updated")
@@ -163,6 +197,16 @@ resource "vault_aws_secret_backend" "test" {
 }`, path)
 }
 
+func testAccAWSSecretBackendConfig_wif(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path                    = "%s"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+  role_arn                = "arn:aws:iam::123456789123:role/vault"
+}`, path, audience, ttl)
+}
+
 func testAccAWSSecretBackendConfig_userTemplate(path, accessKey, secretKey, templ string) string {
 	return fmt.Sprintf(`
 resource "vault_aws_secret_backend" "test" {
@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -83,6 +84,17 @@ func azureSecretBackendResource() *schema.Resource {
 				Default:     "AzurePublicCloud",
 				Description: "The Azure cloud environment. Valid values: AzurePublicCloud, AzureUSGovernmentCloud, AzureChinaCloud, AzureGermanCloud.",
 			},
+			consts.FieldIdentityTokenAudience: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of the plugin identity token. Requires Vault Enterprise 1.16 or later.",
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of the plugin identity token in seconds. Requires Vault Enterprise 1.16 or later.",
+			},
 		},
 	})
 }
@@ -112,7 +124,10 @@ func azureSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(path)
 
 	log.Printf("[DEBUG] Writing Azure configuration to %q", configPath)
-	data := azureSecretBackendRequestData(d)
+	data, err := azureSecretBackendRequestData(d, meta)
+	if err != nil {
+		return err
+	}
 	if _, err := client.Logical().Write(configPath, data); err != nil {
 		return fmt.Errorf("error writing Azure configuration for %q: %s", path, err)
 	}
@@ -152,7 +167,7 @@ func azureSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
 
-	for _, k := range []string{"client_id", "subscription_id", "tenant_id", "use_microsoft_graph_api"} {
+	for _, k := range []string{"client_id", "subscription_id", "tenant_id", "use_microsoft_graph_api", consts.FieldIdentityTokenAudience} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
 				return err
@@ -160,6 +175,16 @@ func azureSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := resp.Data[consts.FieldIdentityTokenTTL].(json.Number); ok {
+		ttl, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for %s of %q: %s", v, consts.FieldIdentityTokenTTL, path, err)
+		}
+		if err := d.Set(consts.FieldIdentityTokenTTL, ttl); err != nil {
+			return err
+		}
+	}
+
 	if v, ok := resp.Data["environment"]; ok && v.(string) != "" {
 		if err := d.Set("environment", v); err != nil {
 			return err
@@ -194,7 +219,10 @@ func azureSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	data := azureSecretBackendRequestData(d)
+	data, err := azureSecretBackendRequestData(d, meta)
+	if err != nil {
+		return err
+	}
 	if len(data) > 0 {
 		_, err := client.Logical().Write(azureSecretBackendPath(path), data)
 		if err != nil {
@@ -244,7 +272,7 @@ func azureSecretBackendPath(path string) string {
 	return strings.Trim(path, "/") + "/config"
 }
 
-func azureSecretBackendRequestData(d *schema.ResourceData) map[string]interface{} {
+func azureSecretBackendRequestData(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
 	fields := []string{
 		"client_id",
 		"environment",
@@ -263,5 +291,16 @@ func azureSecretBackendRequestData(d *schema.ResourceData) map[string]interface{
 		}
 	}
 
-	return data
+	for _, k := range []string{consts.FieldIdentityTokenAudience, consts.FieldIdentityTokenTTL} {
+		v, ok := d.GetOk(k)
+		if d.IsNewResource() && !ok || !d.IsNewResource() && !d.HasChange(k) {
+			continue
+		}
+		if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+			return nil, fmt.Errorf("%s requires Vault 1.16 or later", k)
+		}
+		data[k] = v
+	}
+
+	return data, nil
 }
@@ -92,6 +92,25 @@ func azureSecretBackendRoleResource() *schema.Resource {
 				Optional:    true,
 				Description: "Application Object ID for an existing service principal that will be used instead of creating dynamic service principals.",
 			},
+			"permanently_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the applications and service principals created by Vault will be permanently deleted when the corresponding leases expire.",
+			},
+			"sign_in_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Microsoft accounts supported by the generated applications, one of AzureADMyOrg, AzureADMultipleOrgs, AzureADandPersonalMicrosoftAccount or PersonalMicrosoftAccount. Requires Vault 1.16 or later.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Tags to attach to the generated applications, in the key:value format. Requires Vault 1.16 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"ttl": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -106,7 +125,7 @@ func azureSecretBackendRoleResource() *schema.Resource {
 	}
 }
 
-func azureSecretBackendRoleUpdateFields(_ context.Context, d *schema.ResourceData, data map[string]interface{}) diag.Diagnostics {
+func azureSecretBackendRoleUpdateFields(_ context.Context, d *schema.ResourceData, meta interface{}, data map[string]interface{}) diag.Diagnostics {
 	if v, ok := d.GetOk("azure_roles"); ok {
 		rawAzureList := v.(*schema.Set).List()
 
@@ -150,6 +169,22 @@ func azureSecretBackendRoleUpdateFields(_ context.Context, d *schema.ResourceDat
 		data["application_object_id"] = v.(string)
 	}
 
+	if v, ok := d.GetOkExists("permanently_delete"); ok {
+		data["permanently_delete"] = v.(bool)
+	}
+
+	for _, k := range []string{"sign_in_audience", "tags"} {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+				return diag.Errorf("%s requires Vault 1.16 or later", k)
+			}
+			if k == "tags" {
+				v = v.(*schema.Set).List()
+			}
+			data[k] = v
+		}
+	}
+
 	if v, ok := d.GetOk("ttl"); ok {
 		data["ttl"] = v.(string)
 	}
@@ -173,7 +208,7 @@ func azureSecretBackendRoleCreate(ctx context.Context, d *schema.ResourceData, m
 	path := azureSecretRoleResourcePath(backend, role)
 
 	data := map[string]interface{}{}
-	if diags := azureSecretBackendRoleUpdateFields(ctx, d, data); diags != nil {
+	if diags := azureSecretBackendRoleUpdateFields(ctx, d, meta, data); diags != nil {
 		return diags
 	}
 
@@ -214,6 +249,9 @@ func azureSecretBackendRoleRead(_ context.Context, d *schema.ResourceData, meta
 		"ttl",
 		"max_ttl",
 		"application_object_id",
+		"permanently_delete",
+		"sign_in_audience",
+		"tags",
 	} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
@@ -56,6 +56,51 @@ func TestAzureSecretBackendRole(t *testing.T) {
 	})
 }
 
+func TestAzureSecretBackendRole_applicationOptions(t *testing.T) {
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+	if subscriptionID == "" {
+		t.Skip("ARM_SUBSCRIPTION_ID not set")
+	}
+	tenantID := os.Getenv("ARM_TENANT_ID")
+	clientID := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	resourceGroup := os.Getenv("ARM_RESOURCE_GROUP")
+
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	role := acctest.RandomWithPrefix("tf-test-azure-role")
+	resourceName := "vault_azure_secret_backend_role.test_application_options"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("sign_in_audience and tags require Vault 1.16 or later")
+			}
+		},
+		CheckDestroy: testAccAzureSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureSecretBackendRoleApplicationOptionsConfig(subscriptionID, tenantID, clientID, clientSecret, path, role, resourceGroup, "AzureADMyOrg", "team:engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sign_in_audience", "AzureADMyOrg"),
+					resource.TestCheckResourceAttr(resourceName, "permanently_delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "team:engineering"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "env:test"),
+				),
+			},
+			{
+				Config: testAzureSecretBackendRoleApplicationOptionsConfig(subscriptionID, tenantID, clientID, clientSecret, path, role, resourceGroup, "AzureADMultipleOrgs", "team:platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sign_in_audience", "AzureADMultipleOrgs"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "team:platform"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_azure_secret_backend" {
@@ -145,3 +190,30 @@ resource "vault_azure_secret_backend_role" "test_azure_groups" {
 }
 `, subscriptionID, tenantID, clientID, clientSecret, path, role, resourceGroup)
 }
+
+func testAzureSecretBackendRoleApplicationOptionsConfig(subscriptionID, tenantID, clientID, clientSecret, path, role, resourceGroup, signInAudience, tag string) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "azure" {
+  subscription_id = "%s"
+  tenant_id       = "%s"
+  client_id       = "%s"
+  client_secret   = "%s"
+  path            = "%s"
+}
+
+resource "vault_azure_secret_backend_role" "test_application_options" {
+  backend            = vault_azure_secret_backend.azure.path
+  role               = "%[6]s-application-options"
+  ttl                = 300
+  max_ttl            = 600
+  permanently_delete = true
+  sign_in_audience   = "%[8]s"
+  tags               = ["%[9]s", "env:test"]
+
+  azure_roles {
+    role_name = "Reader"
+    scope     = "/subscriptions/%[1]s/resourceGroups/%[7]s"
+  }
+}
+`, subscriptionID, tenantID, clientID, clientSecret, path, role, resourceGroup, signInAudience, tag)
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func azureSecretBackendRotateRootResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: azureSecretBackendRotateRootCreate,
+		ReadContext:   ReadContextWrapper(azureSecretBackendRotateRootRead),
+		DeleteContext: azureSecretBackendRotateRootDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Azure Secret Backend to rotate the root credentials of.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new rotation.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func azureSecretBackendRotateRootCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := strings.Trim(d.Get(consts.FieldBackend).(string), "/") + "/rotate-root"
+
+	log.Printf("[DEBUG] Rotating Azure root credentials on %q", path)
+	if _, err := client.Logical().Write(path, nil); err != nil {
+		return diag.Errorf("error rotating Azure root credentials on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated Azure root credentials on %q", path)
+
+	d.SetId(resource.UniqueId())
+
+	return azureSecretBackendRotateRootRead(ctx, d, meta)
+}
+
+// azureSecretBackendRotateRootRead is a no-op, the rotation happens once on
+// create.
+func azureSecretBackendRotateRootRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func azureSecretBackendRotateRootDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing Azure root rotation %q from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccAzureSecretBackendRotateRoot(t *testing.T) {
+	// rotating the root credentials invalidates the client secret, use a
+	// dedicated application so other tests are not affected.
+	values := testutil.SkipTestEnvUnset(t,
+		"ARM_SUBSCRIPTION_ID",
+		"ARM_TENANT_ID",
+		"ARM_ROTATE_CLIENT_ID",
+		"ARM_ROTATE_CLIENT_SECRET")
+
+	subscriptionID, tenantID, clientID, clientSecret := values[0], values[1], values[2], values[3]
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	resourceName := "vault_azure_secret_backend_rotate_root.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccAzureSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureSecretBackendRotateRootConfig(path, subscriptionID, tenantID, clientID, clientSecret, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "backend", path),
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "1"),
+				),
+			},
+			{
+				Config: testAccAzureSecretBackendRotateRootConfig(path, subscriptionID, tenantID, clientID, clientSecret, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureSecretBackendRotateRootConfig(path, subscriptionID, tenantID, clientID, clientSecret, rotation string) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "test" {
+  path                    = "%s"
+  subscription_id         = "%s"
+  tenant_id               = "%s"
+  client_id               = "%s"
+  client_secret           = "%s"
+  use_microsoft_graph_api = true
+
+  lifecycle {
+    ignore_changes = [client_secret]
+  }
+}
+
+resource "vault_azure_secret_backend_rotate_root" "test" {
+  backend = vault_azure_secret_backend.test.path
+
+  keepers = {
+    rotation = "%s"
+  }
+}
+`, path, subscriptionID, tenantID, clientID, clientSecret, rotation)
+}
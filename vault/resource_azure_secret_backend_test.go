@@ -62,6 +62,38 @@ func TestAzureSecretBackend(t *testing.T) {
 	})
 }
 
+func TestAzureSecretBackend_wif(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	resourceName := "vault_azure_secret_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("plugin workload identity requires Vault 1.16 or later")
+			}
+		},
+		CheckDestroy: testAccAzureSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureSecretBackend_wif(path, "vault-azure-audience", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-azure-audience"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "600"),
+					resource.TestCheckResourceAttr(resourceName, "client_id", "11111111-2222-3333-4444-333333333333"),
+				),
+			},
+			{
+				Config: testAzureSecretBackend_wif(path, "vault-azure-audience-updated", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-azure-audience-updated"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "1800"),
+				),
+			},
+		},
+	})
+}
+
 func TestAzureSecretBackend_remount(t *testing.T) {
 	path := acctest.RandomWithPrefix("tf-test-azure")
 	updatedPath := acctest.RandomWithPrefix("tf-test-azure-updated")
@@ -139,6 +171,18 @@ func testAzureSecretBackend_initialConfig(path string) string {
 	}`, path)
 }
 
+func testAzureSecretBackend_wif(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+	resource "vault_azure_secret_backend" "test" {
+	 path = "%s"
+	 subscription_id = "11111111-2222-3333-4444-111111111111"
+	 tenant_id = "11111111-2222-3333-4444-222222222222"
+	 client_id = "11111111-2222-3333-4444-333333333333"
+	 identity_token_audience = "%s"
+	 identity_token_ttl = %d
+	}`, path, audience, ttl)
+}
+
 func testAzureSecretBackend_updated(path string) string {
 	return fmt.Sprintf(`
 	resource "vault_azure_secret_backend" "test" {
@@ -245,6 +245,42 @@ func TestConsulSecretBackendRole_Legacy(t *testing.T) {
 	})
 }
 
+func TestConsulSecretBackendRole_deleted(t *testing.T) {
+	if testNewParameters := testutil.CheckTestVaultVersion(t, "1.11"); !testNewParameters {
+		t.Skipf("test requires Vault 1.11 or newer")
+	}
+
+	path := acctest.RandomWithPrefix("tf-test-consul")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	token := "026a0c16-87cd-4c2d-b3f3-fb539f592b7e"
+	resourceName := "vault_consul_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsulSecretBackendRole_initialConfig(path, name, token, false, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+			},
+			{
+				// the role should be recreated after being removed out of band
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+					_, err := client.Logical().Delete(consulSecretBackendRolePath(path, name))
+					if err != nil {
+						t.Fatalf("unable to manually delete the role via the SDK: %s", err)
+					}
+				},
+				Config: testConsulSecretBackendRole_initialConfig(path, name, token, false, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+			},
+		},
+	})
+}
+
 func testAccConsulSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_consul_secret_backend_role" {
@@ -0,0 +1,113 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const controlGroupConfigPath = "sys/config/control-group"
+
+func controlGroupConfigResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: controlGroupConfigWrite,
+		ReadContext:   ReadContextWrapper(controlGroupConfigRead),
+		UpdateContext: controlGroupConfigWrite,
+		DeleteContext: controlGroupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMaxTTL: {
+				Type:     schema.TypeInt,
+				Required: true,
+				Description: "The maximum TTL in seconds for a control group wrapping token, " +
+					"it applies to all control groups of the namespace.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func controlGroupConfigWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldMaxTTL: d.Get(consts.FieldMaxTTL),
+	}
+
+	log.Printf("[DEBUG] Configuring control groups")
+	if _, err := client.Logical().Write(controlGroupConfigPath, data); err != nil {
+		return diag.Errorf("error writing %q: %s", controlGroupConfigPath, err)
+	}
+	log.Printf("[DEBUG] Configured control groups")
+
+	d.SetId(controlGroupConfigPath)
+
+	return controlGroupConfigRead(ctx, d, meta)
+}
+
+func controlGroupConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading %q", controlGroupConfigPath)
+	resp, err := client.Logical().Read(controlGroupConfigPath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", controlGroupConfigPath, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Control group config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	var maxTTL int64
+	switch v := resp.Data[consts.FieldMaxTTL].(type) {
+	case json.Number:
+		maxTTL, err = v.Int64()
+	case string:
+		var dur time.Duration
+		dur, err = time.ParseDuration(v)
+		maxTTL = int64(dur.Seconds())
+	}
+	if err != nil {
+		return diag.Errorf("error parsing %q from %q: %s", consts.FieldMaxTTL, controlGroupConfigPath, err)
+	}
+
+	if err := d.Set(consts.FieldMaxTTL, maxTTL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func controlGroupConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Deleting control group config")
+	if _, err := client.Logical().Delete(controlGroupConfigPath); err != nil {
+		return diag.Errorf("error deleting %q: %s", controlGroupConfigPath, err)
+	}
+	log.Printf("[DEBUG] Deleted control group config")
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestResourceControlGroupConfig(t *testing.T) {
+	resourceName := "vault_control_group_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testControlGroupConfig(3600),
+				Check:  resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "3600"),
+			},
+			{
+				Config: testControlGroupConfig(86400),
+				Check:  resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "86400"),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testControlGroupConfig(maxTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_control_group_config" "test" {
+  max_ttl = %d
+}
+`, maxTTL)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
 )
@@ -95,6 +96,10 @@ var (
 		name:              "snowflake",
 		defaultPluginName: "snowflake" + dbPluginSuffix,
 	}
+	dbEngineRedis = &dbEngine{
+		name:              "redis",
+		defaultPluginName: "redis" + dbPluginSuffix,
+	}
 	dbEngineRedisElastiCache = &dbEngine{
 		name:              "redis_elasticache",
 		defaultPluginName: "redis-elasticache" + dbPluginSuffix,
@@ -120,6 +125,7 @@ var (
 		dbEnginePostgres,
 		dbEngineOracle,
 		dbEngineSnowflake,
+		dbEngineRedis,
 		dbEngineRedisElastiCache,
 		dbEngineRedshift,
 	}
@@ -541,25 +547,69 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 			ConflictsWith: util.CalculateConflictsWith(dbEngineMySQLLegacy.Name(), dbEngineTypes),
 		},
 		dbEnginePostgres.name: {
-			Type:        typ,
-			Optional:    true,
-			Description: "Connection parameters for the postgresql-database-plugin plugin.",
-			Elem: connectionStringResource(&connectionStringConfig{
-				includeUserPass:        true,
-				includeDisableEscaping: true,
-			}),
+			Type:          typ,
+			Optional:      true,
+			Description:   "Connection parameters for the postgresql-database-plugin plugin.",
+			Elem:          postgresConnectionStringResource(),
 			MaxItems:      1,
 			ConflictsWith: util.CalculateConflictsWith(dbEnginePostgres.Name(), dbEngineTypes),
 		},
 		dbEngineOracle.name: {
+			Type:          typ,
+			Optional:      true,
+			Description:   "Connection parameters for the oracle-database-plugin plugin.",
+			Elem:          oracleConnectionStringResource(),
+			MaxItems:      1,
+			ConflictsWith: util.CalculateConflictsWith(dbEngineOracle.Name(), dbEngineTypes),
+		},
+		dbEngineRedis.name: {
 			Type:        typ,
 			Optional:    true,
-			Description: "Connection parameters for the oracle-database-plugin plugin.",
-			Elem: connectionStringResource(&connectionStringConfig{
-				includeUserPass: true,
-			}),
+			Description: "Connection parameters for the redis-database-plugin plugin.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Specifies the host to connect to.",
+					},
+					"port": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      6379,
+						Description:  "The transport port to use to connect to Redis.",
+						ValidateFunc: validation.IsPortNumber,
+					},
+					"username": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Specifies the username for Vault to use.",
+					},
+					"password": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Specifies the password corresponding to the given username.",
+						Sensitive:   true,
+					},
+					"tls": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Specifies whether to use TLS when connecting to Redis.",
+					},
+					"insecure_tls": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Specifies whether to skip verification of the server certificate when using TLS.",
+					},
+					"ca_cert": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The contents of a PEM-encoded CA cert file to use to verify the Redis server's identity.",
+					},
+				},
+			},
 			MaxItems:      1,
-			ConflictsWith: util.CalculateConflictsWith(dbEngineOracle.Name(), dbEngineTypes),
+			ConflictsWith: util.CalculateConflictsWith(dbEngineRedis.Name(), dbEngineTypes),
 		},
 		dbEngineRedisElastiCache.name: {
 			Type:        typ,
@@ -609,12 +659,10 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 			ConflictsWith: util.CalculateConflictsWith(dbEngineRedshift.Name(), dbEngineTypes),
 		},
 		dbEngineSnowflake.name: {
-			Type:        typ,
-			Optional:    true,
-			Description: "Connection parameters for the snowflake-database-plugin plugin.",
-			Elem: connectionStringResource(&connectionStringConfig{
-				includeUserPass: true,
-			}),
+			Type:          typ,
+			Optional:      true,
+			Description:   "Connection parameters for the snowflake-database-plugin plugin.",
+			Elem:          snowflakeConnectionStringResource(),
 			MaxItems:      1,
 			ConflictsWith: util.CalculateConflictsWith(dbEngineSnowflake.Name(), dbEngineTypes),
 		},
@@ -740,6 +788,82 @@ func mssqlConnectionStringResource() *schema.Resource {
 	return r
 }
 
+func postgresConnectionStringResource() *schema.Resource {
+	r := connectionStringResource(&connectionStringConfig{
+		includeUserPass:        true,
+		includeDisableEscaping: true,
+	})
+	r.Schema["password_authentication"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "When set to scram-sha-256, passwords will be hashed by Vault before being sent to PostgreSQL.",
+		ValidateFunc: validation.StringInSlice([]string{"password", "scram-sha-256"}, false),
+	}
+	r.Schema["tls_ca"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The x509 CA file for validating the certificate presented by the PostgreSQL server. Must be PEM encoded.",
+	}
+	r.Schema["tls_certificate"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The x509 client certificate for connecting to the database. Must be PEM encoded.",
+	}
+	r.Schema["private_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The secret key used for the x509 client certificate. Must be PEM encoded.",
+		Sensitive:   true,
+	}
+	r.Schema["auth_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specify alternative authorization type, only gcp_iam is supported.",
+		ValidateFunc: validation.StringInSlice([]string{"gcp_iam"}, false),
+	}
+	r.Schema["service_account_json"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "A JSON encoded credential for use with IAM authorization.",
+		Sensitive:   true,
+	}
+	return r
+}
+
+func oracleConnectionStringResource() *schema.Resource {
+	r := connectionStringResource(&connectionStringConfig{
+		includeUserPass: true,
+	})
+	r.Schema["split_statements"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Set to true to split statements after semi-colons.",
+	}
+	r.Schema["disconnect_sessions"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Set to true to disconnect any open sessions prior to running the revocation statements.",
+	}
+	return r
+}
+
+func snowflakeConnectionStringResource() *schema.Resource {
+	r := connectionStringResource(&connectionStringConfig{
+		includeUserPass: true,
+	})
+	r.Schema["private_key"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "The PEM encoded private key of the user used in the connection URL, " +
+			"authenticating with key-pair instead of the password.",
+		Sensitive: true,
+	}
+	return r
+}
+
 func getDBEngine(d *schema.ResourceData) (*dbEngine, error) {
 	for _, e := range dbEngines {
 		if i, ok := d.GetOk(e.name); ok && len(i.([]interface{})) > 0 {
@@ -867,15 +991,17 @@ func getDatabaseAPIDataForEngine(engine *dbEngine, idx int, d *schema.ResourceDa
 	case dbEngineMySQLLegacy:
 		setDatabaseConnectionDataWithUserPass(d, prefix, data)
 	case dbEngineOracle:
-		setDatabaseConnectionDataWithUserPass(d, prefix, data)
+		setOracleDatabaseConnectionData(d, prefix, data)
 	case dbEnginePostgres:
-		setDatabaseConnectionDataWithDisableEscaping(d, prefix, data)
+		setPostgresDatabaseConnectionData(d, prefix, data)
 	case dbEngineElasticSearch:
 		setElasticsearchDatabaseConnectionData(d, prefix, data)
+	case dbEngineRedis:
+		setRedisDatabaseConnectionData(d, prefix, data)
 	case dbEngineRedisElastiCache:
 		setRedisElastiCacheDatabaseConnectionData(d, prefix, data)
 	case dbEngineSnowflake:
-		setDatabaseConnectionDataWithUserPass(d, prefix, data)
+		setSnowflakeDatabaseConnectionData(d, prefix, data)
 	case dbEngineRedshift:
 		setDatabaseConnectionDataWithDisableEscaping(d, prefix, data)
 	default:
@@ -991,6 +1117,43 @@ func getMySQLConnectionDetailsFromResponse(d *schema.ResourceData, prefix string
 	return result
 }
 
+func getRedisConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) map[string]interface{} {
+	details := resp.Data["connection_details"]
+	data, ok := details.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := map[string]interface{}{}
+
+	if v, ok := data["host"]; ok {
+		result["host"] = v.(string)
+	}
+	if v, ok := data["port"]; ok {
+		port, _ := v.(json.Number).Int64()
+		result["port"] = port
+	}
+	if v, ok := data["username"]; ok {
+		result["username"] = v.(string)
+	}
+	if v, ok := data["password"]; ok {
+		result["password"] = v.(string)
+	} else if v, ok := d.GetOk(prefix + "password"); ok {
+		// keep the password we have in state/config if the API doesn't return one
+		result["password"] = v.(string)
+	}
+	if v, ok := data["tls"]; ok {
+		result["tls"] = v.(bool)
+	}
+	if v, ok := data["insecure_tls"]; ok {
+		result["insecure_tls"] = v.(bool)
+	}
+	if v, ok := data["ca_cert"]; ok {
+		result["ca_cert"] = v.(string)
+	}
+
+	return result
+}
+
 func getRedisElastiCacheConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) map[string]interface{} {
 	details := resp.Data["connection_details"]
 	data, ok := details.(map[string]interface{})
@@ -1167,6 +1330,46 @@ func getInfluxDBConnectionDetailsFromResponse(d *schema.ResourceData, prefix str
 	return result
 }
 
+func getPostgresConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) map[string]interface{} {
+	result := getConnectionDetailsFromResponseWithDisableEscaping(d, prefix, resp)
+	if result == nil {
+		return nil
+	}
+
+	details := resp.Data["connection_details"].(map[string]interface{})
+	for _, k := range []string{"password_authentication", "tls_ca", "tls_certificate", "auth_type"} {
+		if v, ok := details[k]; ok {
+			result[k] = v.(string)
+		}
+	}
+
+	// the private key and the service account credentials are never
+	// returned by Vault
+	for _, k := range []string{"private_key", "service_account_json"} {
+		if v, ok := d.GetOk(prefix + k); ok {
+			result[k] = v.(string)
+		}
+	}
+
+	return result
+}
+
+func getOracleConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) map[string]interface{} {
+	result := getConnectionDetailsFromResponseWithUserPass(d, prefix, resp)
+	if result == nil {
+		return nil
+	}
+
+	details := resp.Data["connection_details"].(map[string]interface{})
+	for _, k := range []string{"split_statements", "disconnect_sessions"} {
+		if v, ok := details[k]; ok {
+			result[k] = v.(bool)
+		}
+	}
+
+	return result
+}
+
 func getSnowflakeConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) map[string]interface{} {
 	details := resp.Data["connection_details"]
 	data, ok := details.(map[string]interface{})
@@ -1195,6 +1398,11 @@ func getSnowflakeConnectionDetailsFromResponse(d *schema.ResourceData, prefix st
 		}
 	}
 
+	// the private key is never returned by Vault
+	if v, ok := d.GetOk(prefix + "private_key"); ok {
+		result["private_key"] = v.(string)
+	}
+
 	return result
 }
 
@@ -1235,7 +1443,7 @@ func setDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[s
 
 func setMSSQLDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
 	setDatabaseConnectionDataWithDisableEscaping(d, prefix, data)
-	if v, ok := d.GetOk(prefix + "contained_db"); ok {
+	if v, ok := d.GetOk(prefix + "contained_db"); ok || d.HasChange(prefix+"contained_db") {
 		// TODO:
 		//  we have to pass string value here due to an issue with the
 		//  way the mssql plugin handles this field. We can probably revert this once vault-1.9.3
@@ -1254,25 +1462,61 @@ func setMySQLDatabaseConnectionData(d *schema.ResourceData, prefix string, data
 	}
 }
 
-func setRedisElastiCacheDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
-	if v, ok := d.GetOk(prefix + "url"); ok {
-		data["url"] = v.(string)
+func setPostgresDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	setDatabaseConnectionDataWithDisableEscaping(d, prefix, data)
+	for _, k := range []string{
+		"password_authentication",
+		"tls_ca",
+		"tls_certificate",
+		"private_key",
+		"auth_type",
+		"service_account_json",
+	} {
+		// send on change as well, so that the settings can be unset
+		if v, ok := d.GetOk(prefix + k); ok || d.HasChange(prefix+k) {
+			data[k] = v.(string)
+		}
+	}
+}
+
+func setOracleDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	setDatabaseConnectionDataWithUserPass(d, prefix, data)
+	data["split_statements"] = d.Get(prefix + "split_statements").(bool)
+	data["disconnect_sessions"] = d.Get(prefix + "disconnect_sessions").(bool)
+}
+
+func setSnowflakeDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	setDatabaseConnectionDataWithUserPass(d, prefix, data)
+	if v, ok := d.GetOk(prefix + "private_key"); ok || d.HasChange(prefix+"private_key") {
+		data["private_key"] = v.(string)
 	}
+}
 
+func setRedisDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	if v, ok := d.GetOk(prefix + "host"); ok {
+		data["host"] = v.(string)
+	}
+	if v, ok := d.GetOkExists(prefix + "port"); ok {
+		data["port"] = v.(int)
+	}
 	if v, ok := d.GetOk(prefix + "username"); ok {
 		data["username"] = v.(string)
 	}
-
 	if v, ok := d.GetOk(prefix + "password"); ok {
 		data["password"] = v.(string)
 	}
-
-	if v, ok := d.GetOk(prefix + "region"); ok {
-		data["region"] = v.(string)
+	if v, ok := d.GetOkExists(prefix + "tls"); ok {
+		data["tls"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists(prefix + "insecure_tls"); ok {
+		data["insecure_tls"] = v.(bool)
+	}
+	if v, ok := d.GetOk(prefix + "ca_cert"); ok || d.HasChange(prefix+"ca_cert") {
+		data["ca_cert"] = v.(string)
 	}
 }
 
-func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+func setRedisElastiCacheDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
 	if v, ok := d.GetOk(prefix + "url"); ok {
 		data["url"] = v.(string)
 	}
@@ -1285,33 +1529,34 @@ func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix strin
 		data["password"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "ca_cert"); ok {
-		data["ca_cert"] = v.(string)
+	if v, ok := d.GetOk(prefix + "region"); ok {
+		data["region"] = v.(string)
 	}
+}
 
-	if v, ok := d.GetOk(prefix + "ca_path"); ok {
-		data["ca_path"] = v.(string)
+func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	if v, ok := d.GetOk(prefix + "url"); ok {
+		data["url"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "client_cert"); ok {
-		data["client_cert"] = v.(string)
+	if v, ok := d.GetOk(prefix + "username"); ok {
+		data["username"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "client_key"); ok {
-		data["client_key"] = v.(string)
+	if v, ok := d.GetOk(prefix + "password"); ok {
+		data["password"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "tls_server_name"); ok {
-		data["tls_server_name"] = v.(string)
+	// send the optional settings on change as well, so that they can be unset
+	for _, k := range []string{"ca_cert", "ca_path", "client_cert", "client_key", "tls_server_name", "username_template"} {
+		if v, ok := d.GetOk(prefix + k); ok || d.HasChange(prefix+k) {
+			data[k] = v.(string)
+		}
 	}
 
-	if v, ok := d.GetOk(prefix + "insecure"); ok {
+	if v, ok := d.GetOk(prefix + "insecure"); ok || d.HasChange(prefix+"insecure") {
 		data["insecure"] = v.(bool)
 	}
-
-	if v, ok := d.GetOk(prefix + "username_template"); ok {
-		data["username_template"] = v.(string)
-	}
 }
 
 func setCouchbaseDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
@@ -1392,7 +1637,7 @@ func setDatabaseConnectionDataWithUserPass(d *schema.ResourceData, prefix string
 func setDatabaseConnectionDataWithDisableEscaping(d *schema.ResourceData, prefix string, data map[string]interface{}) {
 	setDatabaseConnectionDataWithUserPass(d, prefix, data)
 
-	if v, ok := d.GetOk(prefix + "disable_escaping"); ok {
+	if v, ok := d.GetOk(prefix + "disable_escaping"); ok || d.HasChange(prefix+"disable_escaping") {
 		data["disable_escaping"] = v.(bool)
 	}
 }
@@ -1442,6 +1687,12 @@ func writeDatabaseSecretConfig(d *schema.ResourceData, client *api.Client,
 		data["verify_connection"] = v.(bool)
 	}
 
+	for _, k := range []string{consts.FieldPluginVersion, consts.FieldPasswordPolicy} {
+		if v, ok := d.GetOk(prefix + k); ok || d.HasChange(prefix+k) {
+			data[k] = v.(string)
+		}
+	}
+
 	if v, ok := d.GetOkExists(prefix + "allowed_roles"); ok {
 		var roles []string
 		for _, role := range v.([]interface{}) {
@@ -1592,6 +1843,12 @@ func getDBCommonConfig(d *schema.ResourceData, resp *api.Secret,
 		"plugin_name":       resp.Data["plugin_name"],
 	}
 
+	for _, k := range []string{consts.FieldPluginVersion, consts.FieldPasswordPolicy} {
+		if v, ok := resp.Data[k]; ok {
+			result[k] = v
+		}
+	}
+
 	//"root_rotation_statements": resp.Data["root_credentials_rotate_statements"],
 	rootRotationStmts := make([]string, 0)
 	if v, ok := resp.Data["root_credentials_rotate_statements"]; ok && v != nil {
@@ -1642,13 +1899,15 @@ func getDBConnectionConfig(d *schema.ResourceData, engine *dbEngine, idx int,
 	case dbEngineMySQLLegacy:
 		result = getConnectionDetailsFromResponseWithUserPass(d, prefix, resp)
 	case dbEngineOracle:
-		result = getConnectionDetailsFromResponseWithUserPass(d, prefix, resp)
+		result = getOracleConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEnginePostgres:
-		result = getConnectionDetailsFromResponseWithDisableEscaping(d, prefix, resp)
+		result = getPostgresConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineElasticSearch:
 		result = getElasticsearchConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineSnowflake:
 		result = getSnowflakeConnectionDetailsFromResponse(d, prefix, resp)
+	case dbEngineRedis:
+		result = getRedisConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineRedisElastiCache:
 		result = getRedisElastiCacheConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineRedshift:
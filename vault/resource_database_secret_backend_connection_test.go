@@ -24,6 +24,7 @@ import (
 	mssqlhelper "github.com/hashicorp/vault/helper/testhelpers/mssql"
 	"github.com/hashicorp/vault/sdk/database/helper/dbutil"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
@@ -422,6 +423,13 @@ func TestAccDatabaseSecretBackendConnection_mssql(t *testing.T) {
 					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "mssql.0.contained_db", "true"),
 				),
 			},
+			{
+				// ensure contained_db can be disabled again
+				Config: testAccDatabaseSecretBackendConnectionConfig_mssql(name, backend, pluginName, parsedURL, false),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "mssql.0.contained_db", "false"),
+				),
+			},
 			{
 				ResourceName:            testDefaultDatabaseSecretBackendResource,
 				ImportState:             true,
@@ -747,6 +755,105 @@ func TestAccDatabaseSecretBackendConnection_postgresql(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendConnection_postgresql_passwordAuthentication(t *testing.T) {
+	MaybeSkipDBTests(t, dbEnginePostgres)
+
+	values := testutil.SkipTestEnvUnset(t, "POSTGRES_URL")
+	parsedURL, err := url.Parse(values[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	pluginName := dbEnginePostgres.DefaultPluginName()
+	name := acctest.RandomWithPrefix("db")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.14") {
+				t.Skip("password_authentication requires Vault 1.14 or later")
+			}
+		},
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_postgresql_passwordAuthentication(name, backend, "password", parsedURL),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "postgresql.0.password_authentication", "password"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_postgresql_passwordAuthentication(name, backend, "scram-sha-256", parsedURL),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "postgresql.0.password_authentication", "scram-sha-256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseSecretBackendConnection_passwordPolicy(t *testing.T) {
+	MaybeSkipDBTests(t, dbEnginePostgres)
+
+	values := testutil.SkipTestEnvUnset(t, "POSTGRES_URL")
+	parsedURL, err := url.Parse(values[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	pluginName := dbEnginePostgres.DefaultPluginName()
+	name := acctest.RandomWithPrefix("db")
+	policy := acctest.RandomWithPrefix("policy")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_passwordPolicy(name, backend, policy, parsedURL),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, consts.FieldPasswordPolicy, policy),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, consts.FieldPluginVersion, ""),
+				),
+			},
+			{
+				ResourceName:            testDefaultDatabaseSecretBackendResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_connection", "postgresql.0.password"},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseSecretBackendConnection_oracle(t *testing.T) {
+	MaybeSkipDBTests(t, dbEngineOracle)
+
+	// the oracle plugin is not built into Vault, it must be registered
+	// under ORACLE_PLUGIN_NAME beforehand.
+	values := testutil.SkipTestEnvUnset(t, "ORACLE_URL", "ORACLE_PLUGIN_NAME")
+	connURL, pluginName := values[0], values[1]
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_oracle(name, backend, pluginName, connURL),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "oracle.0.connection_url", connURL),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "oracle.0.split_statements", "false"),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "oracle.0.disconnect_sessions", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDatabaseSecretBackendConnection_elasticsearch(t *testing.T) {
 	MaybeSkipDBTests(t, dbEngineElasticSearch)
 
@@ -801,6 +908,14 @@ func TestAccDatabaseSecretBackendConnection_elasticsearch(t *testing.T) {
 					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.tls_server_name", "test"),
 				),
 			},
+			{
+				// ensure the TLS options can be unset
+				Config: testAccDatabaseSecretBackendConnectionConfig_elasticsearch(name, backend, connURL, username, password),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.insecure", "false"),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.tls_server_name", ""),
+				),
+			},
 		},
 	})
 }
@@ -842,6 +957,75 @@ func TestAccDatabaseSecretBackendConnection_snowflake(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendConnection_snowflakeKeyPair(t *testing.T) {
+	MaybeSkipDBTests(t, dbEngineSnowflake)
+
+	values := testutil.SkipTestEnvUnset(t, "SNOWFLAKE_URL", "SNOWFLAKE_USERNAME", "SNOWFLAKE_PRIVATE_KEY")
+	connURL, username, privateKey := values[0], values[1], values[2]
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	pluginName := dbEngineSnowflake.DefaultPluginName()
+	name := acctest.RandomWithPrefix("db")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.17") {
+				t.Skip("snowflake key-pair authentication requires Vault 1.17 or later")
+			}
+		},
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_snowflakeKeyPair(name, backend, connURL, username, privateKey),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "snowflake.0.connection_url", connURL),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "snowflake.0.username", username),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "snowflake.0.password", ""),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "credential_type", "rsa_private_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseSecretBackendConnection_redis(t *testing.T) {
+	MaybeSkipDBTests(t, dbEngineRedis)
+
+	values := testutil.SkipTestEnvUnset(t, "REDIS_HOST", "REDIS_USERNAME", "REDIS_PASSWORD")
+	host, username, password := values[0], values[1], values[2]
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	pluginName := dbEngineRedis.DefaultPluginName()
+	name := acctest.RandomWithPrefix("db")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("the redis plugin requires Vault 1.12 or later")
+			}
+		},
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_redis(name, backend, host, username, password),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "redis.0.host", host),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "redis.0.port", "6379"),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "redis.0.username", username),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "redis.0.tls", "false"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", `["~*","+@read"]`),
+				),
+			},
+			{
+				ResourceName:            testDefaultDatabaseSecretBackendResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_connection", "redis.0.password"},
+			},
+		},
+	})
+}
+
 func TestAccDatabaseSecretBackendConnection_redisElastiCache(t *testing.T) {
 	MaybeSkipDBTests(t, dbEngineRedisElastiCache)
 
@@ -1430,6 +1614,84 @@ resource "vault_database_secret_backend_connection" "test" {
 `, path, name, parsedURL.String(), parsedURL.User.Username(), password, userTempl)
 }
 
+func testAccDatabaseSecretBackendConnectionConfig_postgresql_passwordAuthentication(name, path, passwordAuth string, parsedURL *url.URL) string {
+	password, _ := parsedURL.User.Password()
+
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = vault_mount.db.path
+  name    = "%s"
+
+  postgresql {
+    connection_url          = "%s"
+    username                = "%s"
+    password                = "%s"
+    password_authentication = "%s"
+  }
+}
+`, path, name, parsedURL.String(), parsedURL.User.Username(), password, passwordAuth)
+}
+
+func testAccDatabaseSecretBackendConnectionConfig_passwordPolicy(name, path, policy string, parsedURL *url.URL) string {
+	password, _ := parsedURL.User.Password()
+
+	return fmt.Sprintf(`
+resource "vault_password_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+length = 20
+rule "charset" {
+  charset = "abcdefghijklmnopqrstuvwxyz"
+}
+EOT
+}
+
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend         = vault_mount.db.path
+  name            = "%s"
+  password_policy = vault_password_policy.test.name
+
+  postgresql {
+    connection_url = "%s"
+    username       = "%s"
+    password       = "%s"
+  }
+}
+`, policy, path, name, parsedURL.String(), parsedURL.User.Username(), password)
+}
+
+func testAccDatabaseSecretBackendConnectionConfig_oracle(name, path, pluginName, connURL string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  plugin_name   = "%s"
+  allowed_roles = ["dev", "prod"]
+
+  oracle {
+    connection_url      = "%s"
+    split_statements    = false
+    disconnect_sessions = false
+  }
+}
+`, path, name, pluginName, connURL)
+}
+
 func testAccDatabaseSecretBackendConnectionConfig_snowflake(name, path, url, username, password, userTempl string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "db" {
@@ -1453,6 +1715,70 @@ resource "vault_database_secret_backend_connection" "test" {
 `, path, name, url, username, password, userTempl)
 }
 
+func testAccDatabaseSecretBackendConnectionConfig_snowflakeKeyPair(name, path, url, username, privateKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = ["*"]
+
+  snowflake {
+    connection_url = "%s"
+    username       = "%s"
+    private_key    = <<EOT
+%s
+EOT
+  }
+}
+
+resource "vault_database_secret_backend_role" "test" {
+  backend             = vault_mount.db.path
+  db_name             = vault_database_secret_backend_connection.test.name
+  name                = "dev"
+  credential_type     = "rsa_private_key"
+  creation_statements = [
+    "CREATE USER {{name}} RSA_PUBLIC_KEY='{{public_key}}' DAYS_TO_EXPIRY = {{expiration}} DEFAULT_ROLE=public;",
+  ]
+  credential_config = {
+    key_bits = "2048"
+  }
+}
+`, path, name, url, username, privateKey)
+}
+
+func testAccDatabaseSecretBackendConnectionConfig_redis(name, path, host, username, password string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = ["*"]
+
+  redis {
+    host     = "%s"
+    username = "%s"
+    password = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_role" "test" {
+  backend             = vault_mount.db.path
+  db_name             = vault_database_secret_backend_connection.test.name
+  name                = "readonly"
+  creation_statements = [jsonencode(["~*", "+@read"])]
+}
+`, path, name, host, username, password)
+}
+
 func testAccDatabaseSecretBackendConnectionConfig_redis_elasticache(name, path, connURL string) string {
 	config := fmt.Sprintf(`
 resource "vault_mount" "db" {
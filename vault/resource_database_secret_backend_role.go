@@ -8,17 +8,82 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
+const (
+	dbCredentialTypePassword          = "password"
+	dbCredentialTypeRSAPrivateKey     = "rsa_private_key"
+	dbCredentialTypeClientCertificate = "client_certificate"
+)
+
 var (
 	databaseSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	databaseSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
 )
 
+// databaseSecretBackendRoleCredentialSchema returns the schema of the
+// credential settings shared by the dynamic and static roles.
+func databaseSecretBackendRoleCredentialSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"credential_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "The type of credential to manage, one of " +
+				"password, rsa_private_key or client_certificate.",
+			ValidateFunc: validation.StringInSlice([]string{
+				dbCredentialTypePassword,
+				dbCredentialTypeRSAPrivateKey,
+				dbCredentialTypeClientCertificate,
+			}, false),
+		},
+		"credential_config": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "The configuration of the credential_type, e.g. key_bits and format " +
+				"for rsa_private_key.",
+		},
+	}
+}
+
+func databaseSecretBackendRoleCredentialRequestData(d *schema.ResourceData, meta interface{}, data map[string]interface{}) error {
+	if v, ok := d.GetOk("credential_type"); ok {
+		minVersion := provider.VaultVersion112
+		if v.(string) == dbCredentialTypeClientCertificate {
+			minVersion = provider.VaultVersion114
+		}
+		if !provider.IsAPISupported(meta, minVersion) {
+			return fmt.Errorf("credential_type %q requires Vault %s or later", v, minVersion)
+		}
+		data["credential_type"] = v
+	}
+
+	if v, ok := d.GetOk("credential_config"); ok || d.HasChange("credential_config") {
+		data["credential_config"] = v
+	}
+
+	return nil
+}
+
+func databaseSecretBackendRoleCredentialSetData(d *schema.ResourceData, resp map[string]interface{}) error {
+	for _, k := range []string{"credential_type", "credential_config"} {
+		if v, ok := resp[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func databaseSecretBackendRoleResource() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: databaseSecretBackendRoleWrite,
 		Read:   ReadWrapper(databaseSecretBackendRoleRead),
 		Update: databaseSecretBackendRoleWrite,
@@ -83,6 +148,12 @@ func databaseSecretBackendRoleResource() *schema.Resource {
 			},
 		},
 	}
+
+	for k, v := range databaseSecretBackendRoleCredentialSchema() {
+		r.Schema[k] = v
+	}
+
+	return r
 }
 
 func databaseSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
@@ -116,6 +187,9 @@ func databaseSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 	if v, ok := d.GetOkExists("renew_statements"); ok && v != "" {
 		data["renew_statements"] = v
 	}
+	if err := databaseSecretBackendRoleCredentialRequestData(d, meta, data); err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Creating role %q on database backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
@@ -215,7 +289,8 @@ func databaseSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 		}
 		d.Set("max_ttl", n)
 	}
-	return nil
+
+	return databaseSecretBackendRoleCredentialSetData(d, secret.Data)
 }
 
 func databaseSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
@@ -86,6 +86,43 @@ func TestAccDatabaseSecretBackendRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendRole_credentialType(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("role")
+	dbName := acctest.RandomWithPrefix("db")
+	resourceName := "vault_database_secret_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("credential_type requires Vault 1.12 or later")
+			}
+		},
+		CheckDestroy: testAccDatabaseSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendRoleConfig_credentialType(name, dbName, backend, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "credential_type", "rsa_private_key"),
+					resource.TestCheckResourceAttr(resourceName, "credential_config.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "credential_config.key_bits", "4096"),
+					resource.TestCheckResourceAttr(resourceName, "credential_config.format", "pkcs8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccDatabaseSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_database_secret_backend_role" {
@@ -163,3 +200,34 @@ resource "vault_database_secret_backend_role" "test" {
 }
 `, path, db, connURL, name)
 }
+
+func testAccDatabaseSecretBackendRoleConfig_credentialType(name, db, path, connURL string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = vault_mount.db.path
+  name = "%s"
+  allowed_roles = ["dev", "prod"]
+
+  mysql {
+	  connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_role" "test" {
+  backend             = vault_mount.db.path
+  db_name             = vault_database_secret_backend_connection.test.name
+  name                = "%s"
+  creation_statements = ["SELECT 1;"]
+  credential_type     = "rsa_private_key"
+  credential_config = {
+    key_bits = "4096"
+    format   = "pkcs8"
+  }
+}
+`, path, db, connURL, name)
+}
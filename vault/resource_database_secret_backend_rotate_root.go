@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func databaseSecretBackendRotateRootResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: databaseSecretBackendRotateRootCreate,
+		ReadContext:   ReadContextWrapper(databaseSecretBackendRotateRootRead),
+		DeleteContext: databaseSecretBackendRotateRootDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Database Secret Backend the connection belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the database connection to rotate the root credentials of.",
+			},
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new rotation.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func databaseSecretBackendRotateRootCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := strings.Trim(d.Get(consts.FieldBackend).(string), "/") + "/rotate-root/" + d.Get(consts.FieldName).(string)
+
+	log.Printf("[DEBUG] Rotating database root credentials on %q", path)
+	if _, err := client.Logical().Write(path, nil); err != nil {
+		return diag.Errorf("error rotating database root credentials on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated database root credentials on %q", path)
+
+	d.SetId(resource.UniqueId())
+
+	return databaseSecretBackendRotateRootRead(ctx, d, meta)
+}
+
+// databaseSecretBackendRotateRootRead is a no-op, the rotation happens once on
+// create.
+func databaseSecretBackendRotateRootRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func databaseSecretBackendRotateRootDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing database root rotation %q from state", d.Id())
+
+	return nil
+}
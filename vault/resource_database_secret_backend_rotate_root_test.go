@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDatabaseSecretBackendRotateRoot(t *testing.T) {
+	MaybeSkipDBTests(t, dbEngineMySQL)
+
+	values := testutil.SkipTestEnvUnset(t,
+		"MYSQL_CONNECTION_URL",
+		"MYSQL_CONNECTION_USERNAME",
+		"MYSQL_CONNECTION_PASSWORD")
+
+	connURL, username, password := values[0], values[1], values[2]
+
+	testConnURL := os.Getenv("MYSQL_TEMPLATED_URL")
+	if testConnURL == "" {
+		testConnURL = connURL
+	}
+
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+
+	// rotate the root credentials of a dedicated user, the password in the
+	// configuration is no longer valid afterwards.
+	rootUsername := acctest.RandomWithPrefix("username")
+	rootPassword := acctest.RandomWithPrefix("password")
+	db := newMySQLConnection(t, connURL, username, password)
+	createMySQSUser(t, db, rootUsername, rootPassword)
+	t.Cleanup(func() {
+		deleteMySQLUser(t, db, rootUsername)
+	})
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendRotateRootConfig(name, backend, testConnURL, rootUsername, rootPassword, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vault_database_secret_backend_rotate_root.test", "id"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_rotate_root.test", "keepers.rotation", "1"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendRotateRootConfig(name, backend, testConnURL, rootUsername, rootPassword, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_rotate_root.test", "keepers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseSecretBackendRotateRootConfig(name, path, connURL, username, password, rotation string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = ["dev"]
+
+  mysql {
+    connection_url = "%s"
+    username       = "%s"
+    password       = "%s"
+  }
+
+  lifecycle {
+    ignore_changes = [mysql[0].password]
+  }
+}
+
+resource "vault_database_secret_backend_rotate_root" "test" {
+  backend = vault_mount.db.path
+  name    = vault_database_secret_backend_connection.test.name
+
+  keepers = {
+    rotation = "%s"
+  }
+}
+`, path, name, connURL, username, password, rotation)
+}
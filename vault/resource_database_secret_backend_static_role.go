@@ -18,7 +18,7 @@ var (
 )
 
 func databaseSecretBackendStaticRoleResource() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: databaseSecretBackendStaticRoleWrite,
 		Read:   ReadWrapper(databaseSecretBackendStaticRoleRead),
 		Update: databaseSecretBackendStaticRoleWrite,
@@ -48,9 +48,10 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Description: "The database username that this role corresponds to.",
 			},
 			"rotation_period": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The amount of time Vault should wait before rotating the password, in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"rotation_period", "rotation_schedule"},
+				Description:  "The amount of time Vault should wait before rotating the password, in seconds.",
 				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
 					value := v.(int)
 					if value < 5 {
@@ -59,6 +60,30 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 					return
 				},
 			},
+			"rotation_schedule": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A cron-style string that will define the schedule on which rotations should occur.",
+			},
+			"rotation_window": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_period"},
+				Description: "The amount of time, in seconds, in which rotations are allowed to occur starting " +
+					"from a given rotation_schedule.",
+			},
+			"skip_import_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Skip the rotation of the password of the existing account when the role is created.",
+			},
+			"self_managed_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the account, used by Vault to rotate its own password.",
+			},
 			"db_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -73,6 +98,12 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 			},
 		},
 	}
+
+	for k, v := range databaseSecretBackendRoleCredentialSchema() {
+		r.Schema[k] = v
+	}
+
+	return r
 }
 
 func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
@@ -88,15 +119,48 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 
 	data := map[string]interface{}{
 		"username":            d.Get("username"),
-		"rotation_period":     d.Get("rotation_period"),
 		"db_name":             d.Get("db_name"),
 		"rotation_statements": []string{},
 	}
 
+	if v, ok := d.GetOk("rotation_period"); ok {
+		data["rotation_period"] = v
+	}
+
+	if v, ok := d.GetOk("rotation_schedule"); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion115) {
+			return fmt.Errorf("rotation_schedule requires Vault %s or later", provider.VaultVersion115)
+		}
+		data["rotation_schedule"] = v
+		if v, ok := d.GetOk("rotation_window"); ok {
+			data["rotation_window"] = v
+		}
+	}
+
+	if d.IsNewResource() {
+		if v, ok := d.GetOk("skip_import_rotation"); ok {
+			if !provider.IsAPISupported(meta, provider.VaultVersion118) {
+				return fmt.Errorf("skip_import_rotation requires Vault %s or later", provider.VaultVersion118)
+			}
+			data["skip_import_rotation"] = v
+		}
+	}
+
+	if v, ok := d.GetOk("self_managed_password"); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion118) {
+			return fmt.Errorf("self_managed_password requires Vault %s or later", provider.VaultVersion118)
+		}
+		data["self_managed_password"] = v
+	}
+
 	if v, ok := d.GetOkExists("rotation_statements"); ok && v != "" {
 		data["rotation_statements"] = v
 	}
 
+	if err := databaseSecretBackendRoleCredentialRequestData(d, meta, data); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Creating static role %q on database backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -147,12 +211,18 @@ func databaseSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{
 	d.Set("username", role.Data["username"])
 	d.Set("db_name", role.Data["db_name"])
 
-	if v, ok := role.Data["rotation_period"]; ok {
-		n, err := v.(json.Number).Int64()
-		if err != nil {
-			return fmt.Errorf("unexpected value %q for rotation_period of %q", v, path)
+	for _, k := range []string{"rotation_period", "rotation_window"} {
+		if v, ok := role.Data[k]; ok {
+			n, err := v.(json.Number).Int64()
+			if err != nil {
+				return fmt.Errorf("unexpected value %q for %s of %q", v, k, path)
+			}
+			d.Set(k, n)
 		}
-		d.Set("rotation_period", n)
+	}
+
+	if v, ok := role.Data["rotation_schedule"]; ok {
+		d.Set("rotation_schedule", v)
 	}
 
 	var rotation []string
@@ -168,7 +238,7 @@ func databaseSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("unexpected value %q for rotation_statements of %s: %s", rotation, path, err)
 	}
 
-	return nil
+	return databaseSecretBackendRoleCredentialSetData(d, role.Data)
 }
 
 func databaseSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
@@ -98,6 +98,49 @@ func TestAccDatabaseSecretBackendStaticRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendStaticRole_rotationSchedule(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("staticrole")
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+
+	if err := createTestUser(connURL, username); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.18") {
+				t.Skip("skip_import_rotation requires Vault 1.18 or later")
+			}
+		},
+		CheckDestroy: testAccDatabaseSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotationSchedule(name, username, dbName, backend, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_schedule", "0 0 * * SAT"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_window", "7200"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_period", "0"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "skip_import_rotation", "true"),
+				),
+			},
+			{
+				ResourceName:            "vault_database_secret_backend_static_role.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_import_rotation"},
+			},
+		},
+	})
+}
+
 func testAccDatabaseSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_database_secret_backend_static_role" {
@@ -201,3 +244,33 @@ resource "vault_database_secret_backend_static_role" "test" {
 }
 `, path, db, connURL, name, username)
 }
+
+func testAccDatabaseSecretBackendStaticRoleConfig_rotationSchedule(name, username, db, path, connURL string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = ["*"]
+
+  mysql {
+    connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_static_role" "test" {
+  backend              = vault_mount.db.path
+  db_name              = vault_database_secret_backend_connection.test.name
+  name                 = "%s"
+  username             = "%s"
+  rotation_schedule    = "0 0 * * SAT"
+  rotation_window      = 7200
+  skip_import_rotation = true
+  rotation_statements  = ["ALTER USER '{{username}}'@'localhost' IDENTIFIED BY '{{password}}';"]
+}
+`, path, db, connURL, name, username)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,6 +64,16 @@ func (s *dbConfigStore) init() {
 }
 
 func databaseSecretsMountCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// connections are addressed by name, so only renaming or removing a
+	// connection should force a new resource, not reordering them or
+	// inserting a new one.
+	names := make(map[string]bool)
+	for _, engine := range dbEngines {
+		for i := 0; i < d.Get(fmt.Sprintf("%s.#", engine)).(int); i++ {
+			names[d.Get(fmt.Sprintf("%s.%d.name", engine, i)).(string)] = true
+		}
+	}
+
 	// compute the number of configured database engines
 	var engineCount int
 	for _, engine := range dbEngines {
@@ -72,7 +83,7 @@ func databaseSecretsMountCustomizeDiff(ctx context.Context, d *schema.ResourceDi
 				key := fmt.Sprintf("%s.%d.name", engine, i)
 				o, n := d.GetChange(key)
 				// don't force new on engine addition
-				if o.(string) != "" && o.(string) != n.(string) {
+				if o.(string) != "" && o.(string) != n.(string) && !names[o.(string)] {
 					if err := d.ForceNew(key); err != nil {
 						return err
 					}
@@ -172,6 +183,16 @@ func getCommonDatabaseSchema() schemaMap {
 				return nil, errs
 			},
 		},
+		consts.FieldPluginVersion: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the semantic version of the plugin to use for this connection, e.g. 'v1.0.0'.",
+		},
+		consts.FieldPasswordPolicy: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the password policy to use when generating passwords for this connection.",
+		},
 		"verify_connection": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -292,8 +313,13 @@ func databaseSecretsMountRead(d *schema.ResourceData, meta interface{}) error {
 
 	store := &dbConfigStore{}
 	if v, ok := resp.Data["keys"]; ok {
+		var names []string
 		for _, v := range v.([]interface{}) {
-			if err := readDBEngineConfig(d, client, store, v.(string)); err != nil {
+			names = append(names, v.(string))
+		}
+
+		for _, name := range sortDBConnectionNames(d, names) {
+			if err := readDBEngineConfig(d, client, store, name); err != nil {
 				return err
 			}
 		}
@@ -308,6 +334,33 @@ func databaseSecretsMountRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// sortDBConnectionNames orders the connection names by their position in
+// their engine's configuration blocks, so that the connections are read back in
+// the configured order rather than in the order Vault lists them. Unknown
+// connections, e.g. on import, are kept in the listed order after the
+// configured ones.
+func sortDBConnectionNames(d *schema.ResourceData, names []string) []string {
+	positions := make(map[string]int)
+	for _, engine := range dbEngines {
+		if v, ok := d.GetOk(engine.Name()); ok {
+			for i := range v.([]interface{}) {
+				positions[d.Get(engine.ResourcePrefix(i)+"name").(string)] = i
+			}
+		}
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		pi, iok := positions[names[i]]
+		pj, jok := positions[names[j]]
+		if iok && jok {
+			return pi < pj
+		}
+		return iok && !jok
+	})
+
+	return names
+}
+
 func databaseSecretsMountDelete(d *schema.ResourceData, meta interface{}) error {
 	return mountDelete(d, meta)
 }
@@ -211,6 +211,17 @@ func TestAccDatabaseSecretsMount_mssql_multi(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: importIgnoreKeys,
 			},
+			{
+				// reordering the connections must not recreate the mount
+				Config: testAccDatabaseSecretsMount_mssql_dual(name2, name, backend, pluginName, parsedURL2, parsedURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mssql.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "mssql.0.name", name2),
+					resource.TestCheckResourceAttr(resourceName, "mssql.0.connection_url", connURL2),
+					resource.TestCheckResourceAttr(resourceName, "mssql.1.name", name),
+					resource.TestCheckResourceAttr(resourceName, "mssql.1.connection_url", connURL),
+				),
+			},
 			{
 				Config: testAccDatabaseSecretsMount_mssql(name, backend, pluginName, parsedURL),
 				Check: resource.ComposeTestCheckFunc(
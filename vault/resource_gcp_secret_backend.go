@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -79,10 +80,34 @@ func gcpSecretBackendResource(name string) *schema.Resource {
 				ForceNew:    true,
 				Description: "Local mount flag that can be explicitly set to true to enforce local mount in HA environment",
 			},
+			consts.FieldIdentityTokenAudience: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of the plugin identity token. Requires Vault Enterprise 1.17 or later.",
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of the plugin identity token in seconds. Requires Vault Enterprise 1.17 or later.",
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email of the GCP service account to impersonate with the plugin identity token. Requires Vault Enterprise 1.17 or later.",
+			},
 		},
 	})
 }
 
+// gcpSecretBackendWIFFields are the config fields used to authenticate to GCP
+// with plugin workload identity federation.
+var gcpSecretBackendWIFFields = []string{
+	consts.FieldIdentityTokenAudience,
+	consts.FieldIdentityTokenTTL,
+	"service_account_email",
+}
+
 func gcpSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -116,15 +141,24 @@ func gcpSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(path)
 
 	log.Printf("[DEBUG] Writing GCP configuration to %q", configPath)
+	data := map[string]interface{}{}
 	if credentials != "" {
-		data := map[string]interface{}{
-			"credentials": credentials,
+		data["credentials"] = credentials
+	} else {
+		log.Printf("[DEBUG] No credentials configured")
+	}
+	for _, k := range gcpSecretBackendWIFFields {
+		if v, ok := d.GetOk(k); ok {
+			if !provider.IsAPISupported(meta, provider.VaultVersion117) {
+				return fmt.Errorf("%s requires Vault 1.17 or later", k)
+			}
+			data[k] = v
 		}
+	}
+	if len(data) > 0 {
 		if _, err := client.Logical().Write(configPath, data); err != nil {
 			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
 		}
-	} else {
-		log.Printf("[DEBUG] No credentials configured")
 	}
 	log.Printf("[DEBUG] Wrote GCP configuration to %q", configPath)
 	d.Partial(false)
@@ -162,6 +196,29 @@ func gcpSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 	d.Set("local", mount.Local)
 
+	if provider.IsAPISupported(meta, provider.VaultVersion117) {
+		configPath := gcpSecretBackendConfigPath(path)
+		log.Printf("[DEBUG] Reading GCP configuration from %q", configPath)
+		resp, err := client.Logical().Read(configPath)
+		if err != nil {
+			return fmt.Errorf("error reading GCP configuration for %q: %s", path, err)
+		}
+		if resp != nil {
+			for _, k := range []string{consts.FieldIdentityTokenAudience, "service_account_email"} {
+				if v, ok := resp.Data[k].(string); ok {
+					d.Set(k, v)
+				}
+			}
+			if v, ok := resp.Data[consts.FieldIdentityTokenTTL].(json.Number); ok {
+				ttl, err := v.Int64()
+				if err != nil {
+					return fmt.Errorf("unexpected value %q for %s of %q: %s", v, consts.FieldIdentityTokenTTL, path, err)
+				}
+				d.Set(consts.FieldIdentityTokenTTL, ttl)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -192,15 +249,24 @@ func gcpSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
 	}
 
-	if d.HasChange("credentials") {
-		data := map[string]interface{}{
-			"credentials": d.Get("credentials"),
+	if d.HasChange("credentials") || d.HasChanges(gcpSecretBackendWIFFields...) {
+		data := map[string]interface{}{}
+		if d.HasChange("credentials") {
+			data["credentials"] = d.Get("credentials")
+		}
+		for _, k := range gcpSecretBackendWIFFields {
+			if d.HasChange(k) {
+				if !provider.IsAPISupported(meta, provider.VaultVersion117) {
+					return fmt.Errorf("%s requires Vault 1.17 or later", k)
+				}
+				data[k] = d.Get(k)
+			}
 		}
 		configPath := gcpSecretBackendConfigPath(path)
 		if _, err := client.Logical().Write(configPath, data); err != nil {
-			return fmt.Errorf("error writing GCP credentials for %q: %s", path, err)
+			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
 		}
-		log.Printf("[DEBUG] Updated credentials for %q", path)
+		log.Printf("[DEBUG] Updated configuration for %q", path)
 	}
 
 	d.Partial(false)
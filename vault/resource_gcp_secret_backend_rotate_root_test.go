@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestGCPSecretBackendRotateRoot(t *testing.T) {
+	// rotating the root credentials deletes the configured service account
+	// key, use a dedicated key so other tests are not affected.
+	credentials := testutil.SkipTestEnvUnset(t, "GOOGLE_ROTATE_CREDENTIALS")[0]
+
+	path := acctest.RandomWithPrefix("tf-test-gcp")
+	resourceName := "vault_gcp_secret_backend_rotate_root.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccGCPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretBackendRotateRootConfig(path, credentials, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "backend", path),
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "1"),
+				),
+			},
+			{
+				Config: testGCPSecretBackendRotateRootConfig(path, credentials, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testGCPSecretBackendRotateRootConfig(path, credentials, rotation string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path        = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+
+  lifecycle {
+    ignore_changes = [credentials]
+  }
+}
+
+resource "vault_gcp_secret_backend_rotate_root" "test" {
+  backend = vault_gcp_secret_backend.test.path
+
+  keepers = {
+    rotation = "%s"
+  }
+}
+`, path, credentials, rotation)
+}
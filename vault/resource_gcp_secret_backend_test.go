@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
@@ -49,6 +50,40 @@ func TestGCPSecretBackend(t *testing.T) {
 	})
 }
 
+func TestGCPSecretBackend_wif(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-gcp")
+
+	resourceName := "vault_gcp_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.17") {
+				t.Skip("plugin workload identity for GCP requires Vault 1.17 or later")
+			}
+		},
+		CheckDestroy: testAccGCPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretBackend_wifConfig(path, "vault-gcp-audience", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-gcp-audience"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "600"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", "vault@my-project.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				Config: testGCPSecretBackend_wifConfig(path, "vault-gcp-audience-updated", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-gcp-audience-updated"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "1800"),
+				),
+			},
+		},
+	})
+}
+
 func TestGCPSecretBackend_remount(t *testing.T) {
 	path := acctest.RandomWithPrefix("tf-test-gcp")
 	updatedPath := acctest.RandomWithPrefix("tf-test-gcp-updated")
@@ -142,3 +177,13 @@ EOF
   local = true
 }`, path)
 }
+
+func testGCPSecretBackend_wifConfig(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path                    = "%s"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+  service_account_email   = "vault@my-project.iam.gserviceaccount.com"
+}`, path, audience, ttl)
+}
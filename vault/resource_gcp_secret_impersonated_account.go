@@ -0,0 +1,177 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var gcpSecretImpersonatedAccountPathRegex = regexp.MustCompile("^(.+)/impersonated-account/(.+)$")
+
+func gcpSecretImpersonatedAccountResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(gcpSecretImpersonatedAccountWrite, provider.VaultVersion113),
+		ReadContext:   ReadContextWrapper(gcpSecretImpersonatedAccountRead),
+		UpdateContext: gcpSecretImpersonatedAccountWrite,
+		DeleteContext: gcpSecretImpersonatedAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the GCP secrets engine is mounted.",
+				ForceNew:    true,
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"impersonated_account": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Impersonated Account to create",
+				ForceNew:    true,
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email of the GCP service account to impersonate.",
+			},
+			"token_scopes": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "List of OAuth scopes to assign to access tokens generated under this impersonated account.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Lifetime of the access tokens generated under this impersonated account, in seconds. Requires Vault 1.17 or later.",
+			},
+			"service_account_project": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Project of the GCP Service Account managed by this impersonated account",
+			},
+		},
+	}
+}
+
+func gcpSecretImpersonatedAccountWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := gcpSecretImpersonatedAccountPath(d.Get("backend").(string), d.Get("impersonated_account").(string))
+
+	data := map[string]interface{}{
+		"service_account_email": d.Get("service_account_email"),
+		"token_scopes":          d.Get("token_scopes").(*schema.Set).List(),
+	}
+	if v, ok := d.GetOk("ttl"); ok || d.HasChange("ttl") {
+		if !provider.IsAPISupported(meta, provider.VaultVersion117) {
+			return diag.Errorf("ttl requires Vault 1.17 or later")
+		}
+		data["ttl"] = v
+	}
+
+	log.Printf("[DEBUG] Writing GCP Secrets backend impersonated account %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing GCP Secrets backend impersonated account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote GCP Secrets backend impersonated account %q", path)
+
+	d.SetId(path)
+
+	return gcpSecretImpersonatedAccountRead(ctx, d, meta)
+}
+
+func gcpSecretImpersonatedAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	res := gcpSecretImpersonatedAccountPathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return diag.Errorf("invalid path %q for GCP Secrets backend impersonated account", path)
+	}
+
+	log.Printf("[DEBUG] Reading GCP Secrets backend impersonated account %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading GCP Secrets backend impersonated account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP Secrets backend impersonated account %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] GCP Secrets backend impersonated account %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", res[1]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("impersonated_account", res[2]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range []string{"token_scopes", "service_account_email", "service_account_project"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("error reading %s for GCP Secrets backend impersonated account %q: %s", k, path, err)
+			}
+		}
+	}
+
+	if v, ok := resp.Data["ttl"].(json.Number); ok {
+		ttl, err := v.Int64()
+		if err != nil {
+			return diag.Errorf("unexpected value %q for ttl of GCP Secrets backend impersonated account %q: %s", v, path, err)
+		}
+		if err := d.Set("ttl", ttl); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func gcpSecretImpersonatedAccountDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting GCP Secrets backend impersonated account %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting GCP Secrets backend impersonated account %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted GCP Secrets backend impersonated account %q", path)
+
+	return nil
+}
+
+func gcpSecretImpersonatedAccountPath(backend, impersonatedAccount string) string {
+	return fmt.Sprintf("%s/impersonated-account/%s", strings.Trim(backend, "/"), strings.Trim(impersonatedAccount, "/"))
+}
@@ -94,18 +94,45 @@ func gcpSecretRolesetResource() *schema.Resource {
 				Computed:    true,
 				Description: "Email of the service account created by Vault for this Roleset",
 			},
+			"rotation_keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, rotate the service account of the Roleset, along with its keys and bindings.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_rotation_keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, rotate the service account key used to generate access tokens (`access_token` role sets only).",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 
-		CustomizeDiff: customdiff.ComputedIf("service_account_email", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			log.Printf("[DEBUG] Checking if GCP Secrets backend roleset has changes in `token_scopes` or `binding`")
-			// Due to https://github.com/hashicorp/terraform/issues/17411
-			// we cannot use d.HasChange("binding") directly
-			oldBinding, newBinding := d.GetChange("binding")
-			oldHcl := gcpSecretRenderBindingsFromData(oldBinding)
-			newHcl := gcpSecretRenderBindingsFromData(newBinding)
-
-			return d.HasChange("token_scopes") || oldHcl != newHcl
-		}),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("service_account_email", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				log.Printf("[DEBUG] Checking if GCP Secrets backend roleset has changes in `token_scopes` or `binding`")
+				// Due to https://github.com/hashicorp/terraform/issues/17411
+				// we cannot use d.HasChange("binding") directly
+				oldBinding, newBinding := d.GetChange("binding")
+				oldHcl := gcpSecretRenderBindingsFromData(oldBinding)
+				newHcl := gcpSecretRenderBindingsFromData(newBinding)
+
+				return d.HasChange("token_scopes") || d.HasChange("rotation_keepers") || oldHcl != newHcl
+			}),
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// secret_type is unknown until created, Vault then defaults it to access_token.
+				secretType := d.Get("secret_type").(string)
+				if len(d.Get("key_rotation_keepers").(map[string]interface{})) > 0 &&
+					secretType != "" && secretType != "access_token" {
+					return fmt.Errorf("key_rotation_keepers is only valid for access_token role sets")
+				}
+				return nil
+			},
+		),
 	}
 }
 
@@ -218,6 +245,24 @@ func gcpSecretRolesetUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Updated GCP Secrets backend roleset %q", path)
 
+	// rotations are only triggered on update, a new roleset already has a
+	// fresh service account and key.
+	if d.HasChange("rotation_keepers") {
+		log.Printf("[DEBUG] Rotating service account of GCP Secrets backend roleset %q", path)
+		if _, err := client.Logical().Write(path+"/rotate", nil); err != nil {
+			return fmt.Errorf("error rotating service account of GCP Secrets backend roleset %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated service account of GCP Secrets backend roleset %q", path)
+	}
+
+	if d.HasChange("key_rotation_keepers") {
+		log.Printf("[DEBUG] Rotating key of GCP Secrets backend roleset %q", path)
+		if _, err := client.Logical().Write(path+"/rotate-key", nil); err != nil {
+			return fmt.Errorf("error rotating key of GCP Secrets backend roleset %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated key of GCP Secrets backend roleset %q", path)
+	}
+
 	return gcpSecretRolesetRead(d, meta)
 }
 
@@ -96,6 +96,61 @@ func TestGCPSecretRoleset(t *testing.T) {
 	})
 }
 
+func TestGCPSecretRoleset_rotation(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	roleset := acctest.RandomWithPrefix("tf-test")
+	credentials, project := testutil.GetTestGCPCreds(t)
+
+	resourceName := "vault_gcp_secret_roleset.test"
+
+	var serviceAccountEmail string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testGCPSecretRolesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretRolesetRotationConfig(backend, roleset, credentials, project, "1", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_keepers.rotation", "1"),
+					resource.TestCheckResourceAttr(resourceName, "key_rotation_keepers.rotation", "1"),
+					func(s *terraform.State) error {
+						rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+						if err != nil {
+							return err
+						}
+						serviceAccountEmail = rs.Primary.Attributes["service_account_email"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testGCPSecretRolesetRotationConfig(backend, roleset, credentials, project, "2", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_keepers.rotation", "2"),
+					func(s *terraform.State) error {
+						rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+						if err != nil {
+							return err
+						}
+						if rs.Primary.Attributes["service_account_email"] == serviceAccountEmail {
+							return fmt.Errorf("expected service account %q to be rotated", serviceAccountEmail)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testGCPSecretRolesetRotationConfig(backend, roleset, credentials, project, "2", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key_rotation_keepers.rotation", "2"),
+					testGCPSecretRolesetAttrs(resourceName, backend, roleset),
+				),
+			},
+		},
+	})
+}
+
 func testGCPSecretRolesetAttrs(resourceName, backend, roleset string, ignoreFields ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
@@ -218,6 +273,39 @@ resource "vault_gcp_secret_roleset" "test" {
 	return config
 }
 
+func testGCPSecretRolesetRotationConfig(backend, roleSet, credentials, project, rotation, keyRotation string) string {
+	projectURI := fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", project)
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_roleset" "test" {
+  backend = vault_gcp_secret_backend.test.path
+  roleset = "%s"
+  secret_type = "access_token"
+  project = "%s"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+
+  binding {
+    resource = "%s"
+    roles = ["roles/viewer"]
+  }
+
+  rotation_keepers = {
+    rotation = "%s"
+  }
+
+  key_rotation_keepers = {
+    rotation = "%s"
+  }
+}
+`, backend, credentials, roleSet, project, projectURI, rotation, keyRotation)
+}
+
 func testGCPSecretRolesetServiceAccountKey(backend, roleset, credentials, project, role string) string {
 	projectURI := fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", project)
 	config := fmt.Sprintf(`
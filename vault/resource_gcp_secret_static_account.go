@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -45,11 +46,12 @@ func gcpSecretStaticAccountResource() *schema.Resource {
 				ForceNew:    true,
 			},
 			"secret_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Computed:    true,
-				Description: "Type of secret generated for this static account. Defaults to `access_token`. Accepted values: `access_token`, `service_account_key`",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				Description:  "Type of secret generated for this static account. Defaults to `access_token`. Accepted values: `access_token`, `service_account_key`",
+				ValidateFunc: validation.StringInSlice([]string{"access_token", "service_account_key"}, false),
 			},
 			"service_account_email": {
 				Type:        schema.TypeString,
@@ -231,7 +233,9 @@ func gcpSecretStaticAccountUpdateFields(d *schema.ResourceData, data map[string]
 		data["secret_type"] = v.(string)
 	}
 
-	if v, ok := d.GetOk("token_scopes"); ok && d.Get("secret_type").(string) == "access_token" {
+	// secret_type defaults to access_token when it is not configured
+	secretType := d.Get("secret_type").(string)
+	if v, ok := d.GetOk("token_scopes"); ok && (secretType == "" || secretType == "access_token") {
 		data["token_scopes"] = v.(*schema.Set).List()
 	}
 
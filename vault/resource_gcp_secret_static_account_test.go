@@ -124,6 +124,34 @@ func TestGCPSecretStaticAccount(t *testing.T) {
 	})
 }
 
+func TestGCPSecretStaticAccount_defaultSecretType(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	staticAccount := acctest.RandomWithPrefix("tf-test")
+	credentials, _ := testutil.GetTestGCPCreds(t)
+
+	conf, err := google.JWTConfigFromJSON([]byte(credentials), "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		t.Fatalf("error decoding GCP Credentials: %v", err)
+	}
+
+	resourceName := "vault_gcp_secret_static_account.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testGCPSecretStaticAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretStaticAccount_defaultSecretType(backend, staticAccount, credentials, conf.Email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "access_token"),
+					resource.TestCheckResourceAttr(resourceName, "token_scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_scopes.0", "https://www.googleapis.com/auth/cloud-platform"),
+				),
+			},
+		},
+	})
+}
+
 func testGCPSecretStaticAccountAttrs(resourceName, backend, staticAccount string, ignoreFields ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
@@ -217,6 +245,24 @@ resource "vault_gcp_secret_static_account" "test" {
 `, backend, credentials, staticAccount, serviceAccountEmail)
 }
 
+func testGCPSecretStaticAccount_defaultSecretType(backend, staticAccount, credentials, serviceAccountEmail string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_static_account" "test" {
+  backend               = vault_gcp_secret_backend.test.path
+  static_account        = "%s"
+  token_scopes          = ["https://www.googleapis.com/auth/cloud-platform"]
+  service_account_email = "%s"
+}
+`, backend, credentials, staticAccount, serviceAccountEmail)
+}
+
 func testGCPSecretStaticAccount_accessTokenBinding(backend, staticAccount, credentials, serviceAccountEmail, project, role string) string {
 	projectURI := fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", project)
 	config := fmt.Sprintf(`
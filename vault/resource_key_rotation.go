@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	keyRotatePath = "sys/rotate"
+	keyStatusPath = "sys/key-status"
+)
+
+func keyRotationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: keyRotationCreate,
+		ReadContext:   ReadContextWrapper(keyRotationRead),
+		DeleteContext: keyRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new rotation.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldTerm: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The term of the encryption key after the rotation.",
+			},
+			consts.FieldInstallTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the encryption key was installed.",
+			},
+		},
+	}
+}
+
+func keyRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Rotating the encryption key")
+	if _, err := client.Logical().Write(keyRotatePath, nil); err != nil {
+		return diag.Errorf("error rotating the encryption key: %s", err)
+	}
+	log.Printf("[DEBUG] Rotated the encryption key")
+
+	log.Printf("[DEBUG] Reading %q", keyStatusPath)
+	resp, err := client.Logical().Read(keyStatusPath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", keyStatusPath, err)
+	}
+
+	if resp != nil {
+		if err := d.Set(consts.FieldTerm, resp.Data[consts.FieldTerm]); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set(consts.FieldInstallTime, resp.Data[consts.FieldInstallTime]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return keyRotationRead(ctx, d, meta)
+}
+
+// keyRotationRead is a no-op, the rotation happens once and the resulting
+// key term is recorded on create.
+func keyRotationRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func keyRotationDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing key rotation %q from state", d.Id())
+
+	return nil
+}
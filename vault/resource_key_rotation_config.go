@@ -0,0 +1,155 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	keyRotationConfigPath = "sys/rotate/config"
+
+	// keyRotationDefaultMaxOperations is Vault's default number of
+	// encryptions before the encryption key is rotated automatically.
+	keyRotationDefaultMaxOperations int64 = 3865470566
+)
+
+func keyRotationConfigResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: keyRotationConfigWrite,
+		ReadContext:   ReadContextWrapper(keyRotationConfigRead),
+		UpdateContext: keyRotationConfigWrite,
+		DeleteContext: keyRotationConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether automatic rotation of the encryption key is enabled.",
+			},
+			consts.FieldMaxOperations: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "The number of encryption operations after which the " +
+					"encryption key is rotated.",
+				ValidateFunc: validation.IntAtLeast(1000000),
+			},
+			consts.FieldInterval: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "The interval in seconds after which the encryption key is " +
+					"rotated, 0 disables time based rotation.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func keyRotationConfigWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldEnabled: d.Get(consts.FieldEnabled),
+	}
+
+	// an interval of 0 disables time based rotation, so fields are also sent
+	// when they are changed to their zero value.
+	for _, k := range []string{consts.FieldMaxOperations, consts.FieldInterval} {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Configuring encryption key rotation")
+	if _, err := client.Logical().Write(keyRotationConfigPath, data); err != nil {
+		return diag.Errorf("error writing %q: %s", keyRotationConfigPath, err)
+	}
+	log.Printf("[DEBUG] Configured encryption key rotation")
+
+	d.SetId(keyRotationConfigPath)
+
+	return keyRotationConfigRead(ctx, d, meta)
+}
+
+func keyRotationConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading %q", keyRotationConfigPath)
+	resp, err := client.Logical().Read(keyRotationConfigPath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", keyRotationConfigPath, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Key rotation config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{consts.FieldEnabled, consts.FieldMaxOperations} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on key rotation config, err=%s", k, err)
+		}
+	}
+
+	var interval int64
+	switch v := resp.Data[consts.FieldInterval].(type) {
+	case json.Number:
+		interval, err = v.Int64()
+	case string:
+		var dur time.Duration
+		dur, err = time.ParseDuration(v)
+		interval = int64(dur.Seconds())
+	}
+	if err != nil {
+		return diag.Errorf("error parsing %q from %q: %s", consts.FieldInterval, keyRotationConfigPath, err)
+	}
+
+	if err := d.Set(consts.FieldInterval, interval); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// keyRotationConfigDelete restores Vault's default rotation config.
+func keyRotationConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldEnabled:       true,
+		consts.FieldMaxOperations: keyRotationDefaultMaxOperations,
+		consts.FieldInterval:      0,
+	}
+
+	log.Printf("[DEBUG] Resetting encryption key rotation config")
+	if _, err := client.Logical().Write(keyRotationConfigPath, data); err != nil {
+		return diag.Errorf("error resetting %q: %s", keyRotationConfigPath, err)
+	}
+	log.Printf("[DEBUG] Reset encryption key rotation config")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKeyRotationConfig(t *testing.T) {
+	resourceName := "vault_key_rotation_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationConfigConfig(true, 2000000, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxOperations, "2000000"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldInterval, "86400"),
+				),
+			},
+			{
+				Config: testAccKeyRotationConfigConfig(false, 3000000, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "false"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxOperations, "3000000"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldInterval, "0"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccKeyRotationConfigConfig(enabled bool, maxOperations, interval int) string {
+	return fmt.Sprintf(`
+resource "vault_key_rotation_config" "test" {
+  enabled        = %t
+  max_operations = %d
+  interval       = %d
+}
+`, enabled, maxOperations, interval)
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKeyRotation(t *testing.T) {
+	resourceName := "vault_key_rotation.test"
+
+	var term int
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldInstallTime),
+					testAccKeyRotationCheckTerm(resourceName, &term),
+				),
+			},
+			{
+				Config: testAccKeyRotationConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccKeyRotationCheckTerm(resourceName, &term),
+				),
+			},
+		},
+	})
+}
+
+// testAccKeyRotationCheckTerm checks that the key term increased since the
+// previous check.
+func testAccKeyRotationCheckTerm(resourceName string, prev *int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		term, err := strconv.Atoi(rs.Primary.Attributes[consts.FieldTerm])
+		if err != nil {
+			return err
+		}
+
+		if term <= *prev {
+			return fmt.Errorf("expected key term to be greater than %d, got %d", *prev, term)
+		}
+		*prev = term
+
+		return nil
+	}
+}
+
+func testAccKeyRotationConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "vault_key_rotation" "test" {
+  keepers = {
+    rotation = "%s"
+  }
+}
+`, rotation)
+}
@@ -0,0 +1,172 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const fieldKMIPSerialNumber = "serial_number"
+
+func kmipSecretCredentialResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretCredentialCreate,
+		Read:   ReadWrapper(kmipSecretCredentialRead),
+		Delete: kmipSecretCredentialDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldPath: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path where KMIP backend is mounted",
+				ValidateFunc: provider.ValidateNoLeadingTrailingSlashes,
+			},
+			consts.FieldScope: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the scope",
+			},
+			consts.FieldRole: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role",
+			},
+			consts.FieldFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "Format of the generated certificate and key, one of pem, der or pem_bundle",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			fieldKMIPSerialNumber: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Serial number of the client certificate",
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Client certificate",
+			},
+			consts.FieldPrivateKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Private key of the client certificate",
+			},
+			consts.FieldCAChain: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "CA chain of the client certificate",
+			},
+		},
+	}
+}
+
+func kmipSecretCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	credentialPath := getKMIPCredentialPath(d)
+	data := map[string]interface{}{
+		consts.FieldFormat: d.Get(consts.FieldFormat),
+	}
+
+	log.Printf("[DEBUG] Generating KMIP credential at %q", credentialPath)
+	resp, err := client.Logical().Write(credentialPath+"/generate", data)
+	if err != nil {
+		return fmt.Errorf("error generating KMIP credential at %q, err=%w", credentialPath, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("expected a KMIP credential at %q, got an empty response", credentialPath)
+	}
+	log.Printf("[DEBUG] Generated KMIP credential at %q", credentialPath)
+
+	serialNumber, ok := resp.Data[fieldKMIPSerialNumber].(string)
+	if !ok || serialNumber == "" {
+		return fmt.Errorf("expected a serial number for the KMIP credential at %q", credentialPath)
+	}
+	d.SetId(serialNumber)
+
+	// the private key is only returned on generation
+	for _, k := range []string{fieldKMIPSerialNumber, consts.FieldCertificate, consts.FieldPrivateKey, consts.FieldCAChain} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on KMIP credential, err=%w", k, err)
+		}
+	}
+
+	return kmipSecretCredentialRead(d, meta)
+}
+
+func kmipSecretCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	credentialPath := getKMIPCredentialPath(d)
+	serialNumber := d.Id()
+
+	log.Printf("[DEBUG] Looking up KMIP credential %q at %q", serialNumber, credentialPath)
+	resp, err := client.Logical().ReadWithData(credentialPath+"/lookup", map[string][]string{
+		fieldKMIPSerialNumber: {serialNumber},
+	})
+	if err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] KMIP credential %q not found, removing from state", serialNumber)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error looking up KMIP credential %q at %q, err=%w", serialNumber, credentialPath, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] KMIP credential %q not found, removing from state", serialNumber)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(fieldKMIPSerialNumber, serialNumber); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func kmipSecretCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	credentialPath := getKMIPCredentialPath(d)
+	serialNumber := d.Id()
+
+	log.Printf("[DEBUG] Revoking KMIP credential %q at %q", serialNumber, credentialPath)
+	if _, err := client.Logical().Write(credentialPath+"/revoke", map[string]interface{}{
+		fieldKMIPSerialNumber: serialNumber,
+	}); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error revoking KMIP credential %q at %q, err=%w", serialNumber, credentialPath, err)
+	}
+	log.Printf("[DEBUG] Revoked KMIP credential %q", serialNumber)
+
+	return nil
+}
+
+func getKMIPCredentialPath(d *schema.ResourceData) string {
+	return getKMIPRolePath(d) + "/credential"
+}
@@ -0,0 +1,120 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKMIPSecretCredential_basic(t *testing.T) {
+	testutil.SkipTestAccEnt(t)
+
+	path := acctest.RandomWithPrefix("tf-test-kmip")
+	resourceName := "vault_kmip_secret_credential.test"
+
+	lns, closer, err := testutil.GetDynamicTCPListeners("127.0.0.1", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = closer(); err != nil {
+		t.Fatal(err)
+	}
+
+	addr1 := lns[0].Addr().String()
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretCredentialCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKMIPSecretCredential_config(path, addr1, "pem"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldScope, "scope-1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRole, "test"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldFormat, "pem"),
+					resource.TestCheckResourceAttrSet(resourceName, fieldKMIPSerialNumber),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldCertificate),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldPrivateKey),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldCAChain+".0"),
+				),
+			},
+			{
+				Config: testKMIPSecretCredential_config(path, addr1, "pem_bundle"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldFormat, "pem_bundle"),
+					resource.TestCheckResourceAttrSet(resourceName, fieldKMIPSerialNumber),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldCertificate),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldPrivateKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretCredentialCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_credential" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		path := fmt.Sprintf("%s/scope/%s/role/%s/credential/lookup",
+			rs.Primary.Attributes[consts.FieldPath],
+			rs.Primary.Attributes[consts.FieldScope],
+			rs.Primary.Attributes[consts.FieldRole])
+		resp, err := client.Logical().ReadWithData(path, map[string][]string{
+			fieldKMIPSerialNumber: {rs.Primary.ID},
+		})
+		// the whole mount is removed along with the credential
+		if err == nil && resp != nil {
+			return fmt.Errorf("KMIP credential %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testKMIPSecretCredential_config(path, listenAddr, format string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "kmip" {
+  path         = "%s"
+  listen_addrs = ["%s"]
+  description  = "test description"
+}
+
+resource "vault_kmip_secret_scope" "scope-1" {
+  path  = vault_kmip_secret_backend.kmip.path
+  scope = "scope-1"
+}
+
+resource "vault_kmip_secret_role" "test" {
+  path                = vault_kmip_secret_scope.scope-1.path
+  scope               = vault_kmip_secret_scope.scope-1.scope
+  role                = "test"
+  tls_client_key_type = "ec"
+  tls_client_key_bits = 256
+  operation_all       = true
+}
+
+resource "vault_kmip_secret_credential" "test" {
+  path   = vault_kmip_secret_role.test.path
+  scope  = vault_kmip_secret_role.test.scope
+  role   = vault_kmip_secret_role.test.role
+  format = "%s"
+}
+`, path, listenAddr, format)
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,6 +19,8 @@ var kubernetesSecretBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 
 const (
 	fieldAllowedKubernetesNamespaces = "allowed_kubernetes_namespaces"
+	fieldAllowedNamespaceSelector    = "allowed_kubernetes_namespace_selector"
+	fieldTokenDefaultAudiences       = "token_default_audiences"
 	fieldTokenMaxTTL                 = "token_max_ttl"
 	fieldTokenDefaultTTL             = "token_default_ttl"
 	fieldServiceAccountName          = "service_account_name"
@@ -29,6 +32,13 @@ const (
 	fieldExtraLabels                 = "extra_labels"
 )
 
+// kubernetesSecretBackendRoleVersionedFields maps the role fields that are only
+// supported by newer versions of Vault to the minimum version required.
+var kubernetesSecretBackendRoleVersionedFields = map[string]*version.Version{
+	fieldAllowedNamespaceSelector: provider.VaultVersion115,
+	fieldTokenDefaultAudiences:    provider.VaultVersion116,
+}
+
 func kubernetesSecretBackendRoleResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: MountCreateContextWrapper(kubernetesSecretBackendRoleCreateUpdate, provider.VaultVersion111),
@@ -61,7 +71,14 @@ func kubernetesSecretBackendRoleResource() *schema.Resource {
 				},
 				Description: "The list of Kubernetes namespaces this role can generate " +
 					"credentials for. If set to '*' all namespaces are allowed.",
-				Required: true,
+				Optional:     true,
+				AtLeastOneOf: []string{fieldAllowedKubernetesNamespaces, fieldAllowedNamespaceSelector},
+			},
+			fieldAllowedNamespaceSelector: {
+				Type: schema.TypeString,
+				Description: "A label selector for Kubernetes namespaces in which credentials " +
+					"can be generated. Accepts either a JSON or YAML object. Requires Vault 1.15 or later.",
+				Optional: true,
 			},
 			fieldTokenMaxTTL: {
 				Type:        schema.TypeInt,
@@ -75,6 +92,15 @@ func kubernetesSecretBackendRoleResource() *schema.Resource {
 				Optional:    true,
 				Default:     0,
 			},
+			fieldTokenDefaultAudiences: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The default audiences for generated Kubernetes tokens. If unset, " +
+					"the audience of the Kubernetes API server is used. Requires Vault 1.16 or later.",
+				Optional: true,
+			},
 			fieldServiceAccountName: {
 				Type: schema.TypeString,
 				Description: "The pre-existing service account to generate tokens for. " +
@@ -156,6 +182,16 @@ func kubernetesSecretBackendRoleCreateUpdate(ctx context.Context, d *schema.Reso
 		}
 	}
 
+	for k, v := range kubernetesSecretBackendRoleVersionedFields {
+		if !d.HasChange(k) {
+			continue
+		}
+		if !provider.IsAPISupported(meta, v) {
+			return diag.Errorf("%q requires Vault %s or later", k, v)
+		}
+		data[k] = d.Get(k)
+	}
+
 	name := d.Get(consts.FieldName).(string)
 	backend := d.Get(consts.FieldBackend).(string)
 	rolePath := kubernetesSecretBackendRolePath(backend, name)
@@ -214,6 +250,15 @@ func kubernetesSecretBackendRoleRead(_ context.Context, d *schema.ResourceData,
 		}
 	}
 
+	for k := range kubernetesSecretBackendRoleVersionedFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("error setting state key %q on Kubernetes backend role, err=%s",
+					k, err)
+			}
+		}
+	}
+
 	return nil
 }
 
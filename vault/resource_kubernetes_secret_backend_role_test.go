@@ -84,6 +84,40 @@ func TestAccKubernetesSecretBackendRole(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesSecretBackendRole_namespaceSelector(t *testing.T) {
+	testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+
+	resourceName := "vault_kubernetes_secret_backend_role.test"
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("tf-test-role")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("token_default_audiences requires Vault 1.16 or later")
+			}
+		},
+		CheckDestroy: testAccKubernetesSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKubernetesSecretBackendRole_namespaceSelectorConfig(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, fieldAllowedKubernetesNamespaces+".#", "0"),
+					resource.TestCheckResourceAttr(resourceName, fieldAllowedNamespaceSelector, `{"matchLabels":{"team":"dev"}}`),
+					resource.TestCheckResourceAttr(resourceName, fieldTokenDefaultAudiences+".#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fieldTokenDefaultAudiences+".0", "vault"),
+					resource.TestCheckResourceAttr(resourceName, fieldTokenDefaultAudiences+".1", "https://kubernetes.default.svc"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
 func testAccKubernetesSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_kubernetes_secret_backend_role" {
@@ -165,3 +199,19 @@ resource "vault_kubernetes_secret_backend_role" "test" {
 }
 `, backend, name)
 }
+
+func testKubernetesSecretBackendRole_namespaceSelectorConfig(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "backend" {
+  path = "%s"
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend                               = vault_kubernetes_secret_backend.backend.path
+  name                                  = "%s"
+  allowed_kubernetes_namespace_selector = jsonencode({ matchLabels = { team = "dev" } })
+  service_account_name                  = "test-service-account-with-generated-token"
+  token_default_audiences               = ["vault", "https://kubernetes.default.svc"]
+}
+`, backend, name)
+}
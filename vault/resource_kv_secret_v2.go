@@ -8,9 +8,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 func kvSecretV2Resource(name string) *schema.Resource {
@@ -64,6 +66,24 @@ func kvSecretV2Resource(name string) *schema.Resource {
 				Default:  false,
 				Description: "If set to true, disables reading secret from Vault; " +
 					"note: drift won't be detected.",
+				ConflictsWith: []string{consts.FieldReadMetadataOnly},
+			},
+
+			consts.FieldReadMetadataOnly: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set to true, only the secret's metadata is read " +
+					"from Vault during refresh. Drift is detected when the current " +
+					"version of the secret differs from the version last written by " +
+					"Terraform, the secret data is not read.",
+				ConflictsWith: []string{"disable_read"},
+			},
+
+			consts.FieldVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The current version of the secret.",
 			},
 
 			// Data is passed as JSON so that an arbitrary structure is
@@ -95,10 +115,21 @@ func kvSecretV2Resource(name string) *schema.Resource {
 			},
 
 			"delete_all_versions": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "If set to true, permanently deletes all versions for the specified key.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "If set to true, permanently deletes all versions for the specified key.",
+				ConflictsWith: []string{consts.FieldPatch},
+			},
+
+			consts.FieldPatch: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set to true, only the keys in data_json are managed, " +
+					"they are written with the KV-V2 patch endpoint and other keys of " +
+					"the secret are left untouched. Requires Vault 1.9 or later.",
+				ConflictsWith: []string{"delete_all_versions"},
 			},
 		},
 	}
@@ -134,15 +165,135 @@ func kvSecretV2Write(ctx context.Context, d *schema.ResourceData, meta interface
 		data[k] = d.Get(k)
 	}
 
-	if _, err := client.Logical().Write(path, data); err != nil {
+	if d.Get(consts.FieldPatch).(bool) {
+		if diags := kvSecretV2Patch(ctx, d, meta, path, data); diags != nil {
+			return diags
+		}
+	} else if _, err := client.Logical().Write(path, data); err != nil {
 		return diag.Errorf("error writing secret data to %s, err=%s", path, err)
 	}
 
 	d.SetId(path)
 
+	// record the version written, the next refresh compares it against the
+	// current version of the secret
+	if d.Get(consts.FieldReadMetadataOnly).(bool) {
+		version, _, err := kvSecretV2CurrentVersion(client, mount, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set(consts.FieldVersion, version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return kvSecretV2Read(ctx, d, meta)
 }
 
+// kvSecretV2CurrentVersion returns the current version of the secret along
+// with its metadata. A nil metadata map is returned if the secret does not
+// exist.
+func kvSecretV2CurrentVersion(client *api.Client, mount, name string) (int, map[string]interface{}, error) {
+	path := getKVV2Path(mount, name, consts.FieldMetadata)
+
+	log.Printf("[DEBUG] Reading metadata for %q from Vault", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error reading metadata for %q from Vault: %s", path, err)
+	}
+	if resp == nil {
+		return 0, nil, nil
+	}
+
+	v, ok := resp.Data["current_version"].(json.Number)
+	if !ok {
+		return 0, nil, fmt.Errorf("unexpected current_version %v at %q", resp.Data["current_version"], path)
+	}
+
+	version, err := v.Int64()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	versions, _ := resp.Data[consts.FieldVersions].(map[string]interface{})
+	current, _ := versions[v.String()].(map[string]interface{})
+
+	// mirror the metadata returned when reading the secret data
+	metadata := map[string]interface{}{
+		consts.FieldVersion:        v,
+		consts.FieldCustomMetadata: resp.Data[consts.FieldCustomMetadata],
+	}
+	for _, k := range []string{"created_time", "deletion_time", "destroyed"} {
+		metadata[k] = current[k]
+	}
+
+	return int(version), metadata, nil
+}
+
+// kvSecretV2Patch writes the managed keys of the secret with the patch
+// endpoint, keys removed from data_json are deleted from the secret.
+func kvSecretV2Patch(ctx context.Context, d *schema.ResourceData, meta interface{}, path string, data map[string]interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion190) {
+		return diag.Errorf("%q requires Vault %s or later", consts.FieldPatch, provider.VaultVersion190)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	// the patch endpoint requires the secret to exist
+	if d.IsNewResource() {
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return diag.Errorf("error reading from Vault: %s", err)
+		}
+
+		if resp == nil {
+			if _, err := client.Logical().Write(path, data); err != nil {
+				return diag.Errorf("error writing secret data to %s, err=%s", path, err)
+			}
+			return nil
+		}
+	}
+
+	if d.HasChange(consts.FieldDataJSON) {
+		secretData := data["data"].(map[string]interface{})
+		for k := range kvSecretV2ManagedKeys(d) {
+			if _, ok := secretData[k]; !ok {
+				secretData[k] = nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Patching secret data at %q", path)
+	if _, err := client.Logical().JSONMergePatch(ctx, path, data); err != nil {
+		return diag.Errorf("error patching secret data at %s, err=%s", path, err)
+	}
+	log.Printf("[DEBUG] Patched secret data at %q", path)
+
+	return nil
+}
+
+// kvSecretV2ManagedKeys returns the keys of the previously applied
+// data_json.
+func kvSecretV2ManagedKeys(d *schema.ResourceData) map[string]bool {
+	o, _ := d.GetChange(consts.FieldDataJSON)
+
+	var oldData map[string]interface{}
+	if err := json.Unmarshal([]byte(o.(string)), &oldData); err != nil {
+		return nil
+	}
+
+	keys := make(map[string]bool, len(oldData))
+	for k := range oldData {
+		keys[k] = true
+	}
+
+	return keys
+}
+
 func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	shouldRead := !d.Get("disable_read").(bool)
 
@@ -152,6 +303,10 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
+	if d.Get(consts.FieldReadMetadataOnly).(bool) {
+		return kvSecretV2ReadMetadataOnly(d, meta)
+	}
+
 	if shouldRead {
 		client, e := provider.GetClient(d, meta)
 		if e != nil {
@@ -174,6 +329,18 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 		data := secret.Data["data"]
 
 		if v, ok := data.(map[string]interface{}); ok {
+			// only the managed keys are kept in state in patch mode
+			if d.Get(consts.FieldPatch).(bool) {
+				var managed map[string]interface{}
+				if err := json.Unmarshal([]byte(d.Get(consts.FieldDataJSON).(string)), &managed); err == nil {
+					for k := range v {
+						if _, ok := managed[k]; !ok {
+							delete(v, k)
+						}
+					}
+				}
+			}
+
 			if err := d.Set(consts.FieldData, serializeDataMapToString(v)); err != nil {
 				return diag.FromErr(err)
 			}
@@ -184,6 +351,10 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 				if err := d.Set(consts.FieldMetadata, serializeDataMapToString(v)); err != nil {
 					return diag.FromErr(err)
 				}
+
+				if err := d.Set(consts.FieldVersion, v[consts.FieldVersion]); err != nil {
+					return diag.FromErr(err)
+				}
 			}
 		}
 	}
@@ -191,7 +362,9 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func kvSecretV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// kvSecretV2ReadMetadataOnly detects drift from the secret's metadata,
+// without reading the secret data.
+func kvSecretV2ReadMetadataOnly(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
 		return diag.FromErr(e)
@@ -200,6 +373,51 @@ func kvSecretV2Delete(_ context.Context, d *schema.ResourceData, meta interface{
 	mount := d.Get(consts.FieldMount).(string)
 	name := d.Get(consts.FieldName).(string)
 
+	version, metadata, err := kvSecretV2CurrentVersion(client, mount, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if metadata == nil {
+		log.Printf("[WARN] secret (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	deleted := metadata["deletion_time"] != "" || metadata["destroyed"] == true
+	if last := d.Get(consts.FieldVersion).(int); deleted || (last != 0 && last != version) {
+		// the secret was changed outside of Terraform, clearing data_json
+		// forces it to be written again on the next apply
+		log.Printf("[WARN] secret (%s) version %d differs from version %d written by Terraform",
+			d.Id(), version, last)
+		if err := d.Set(consts.FieldDataJSON, ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set(consts.FieldVersion, version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldMetadata, serializeDataMapToString(metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func kvSecretV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	if d.Get(consts.FieldPatch).(bool) {
+		return kvSecretV2DeletePatch(ctx, d, meta, getKVV2Path(mount, name, consts.FieldData))
+	}
+
 	base := consts.FieldData
 	deleteAllVersions := d.Get("delete_all_versions").(bool)
 	if deleteAllVersions {
@@ -216,3 +434,34 @@ func kvSecretV2Delete(_ context.Context, d *schema.ResourceData, meta interface{
 
 	return nil
 }
+
+// kvSecretV2DeletePatch removes only the managed keys from the secret.
+func kvSecretV2DeletePatch(ctx context.Context, d *schema.ResourceData, meta interface{}, path string) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	var managed map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get(consts.FieldDataJSON).(string)), &managed); err != nil {
+		return diag.Errorf("data_json %#v syntax error: %s", d.Get(consts.FieldDataJSON), err)
+	}
+
+	secretData := make(map[string]interface{}, len(managed))
+	for k := range managed {
+		secretData[k] = nil
+	}
+
+	log.Printf("[DEBUG] Removing managed keys from secret data at %q", path)
+	if _, err := client.Logical().JSONMergePatch(ctx, path, map[string]interface{}{
+		"data": secretData,
+	}); err != nil {
+		if util.Is404(err) {
+			return nil
+		}
+		return diag.Errorf("error removing managed keys from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed managed keys from secret data at %q", path)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var kvSecretV2MetadataFields = []string{"max_versions", "cas_required", "delete_version_after"}
+
+func kvSecretV2MetadataResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kvSecretV2MetadataWrite,
+		UpdateContext: kvSecretV2MetadataWrite,
+		DeleteContext: kvSecretV2MetadataDelete,
+		ReadContext:   ReadContextWrapper(kvSecretV2MetadataRead),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and metadata " +
+					"prefix. For example, for a secret at 'kvv2/metadata/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'",
+			},
+			consts.FieldPath: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the KV-V2 secret metadata.",
+			},
+			"max_versions": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The number of versions to keep for the secret, " +
+					"0 uses the engine's setting.",
+			},
+			"cas_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "If true, all writes to the secret will require the cas " +
+					"parameter to be set.",
+			},
+			"delete_version_after": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The length of time in seconds before a version is deleted, " +
+					"0 uses the engine's setting.",
+			},
+			consts.FieldCustomMetadata: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A map of arbitrary string to string valued user-provided metadata.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kvSecretV2MetadataWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	path := getKVV2Path(mount, name, consts.FieldMetadata)
+
+	data := map[string]interface{}{
+		consts.FieldCustomMetadata: d.Get(consts.FieldCustomMetadata),
+	}
+	for _, k := range kvSecretV2MetadataFields {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing secret metadata to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing secret metadata to %s, err=%s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote secret metadata to %q", path)
+
+	d.SetId(path)
+
+	return kvSecretV2MetadataRead(ctx, d, meta)
+}
+
+func kvSecretV2MetadataRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	// mount and name are not set on import, derive them from the path.
+	if idx := strings.Index(path, "/"+consts.FieldMetadata+"/"); idx > 0 {
+		if err := d.Set(consts.FieldMount, path[:idx]); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(consts.FieldName, path[idx+len(consts.FieldMetadata)+2:]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set(consts.FieldPath, path); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading %s from Vault", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading from Vault: %s", err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] secret metadata (%s) not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{"max_versions", "cas_required", consts.FieldCustomMetadata} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// convert delete_version_after to seconds
+	if v, ok := resp.Data["delete_version_after"].(string); ok {
+		t, err := time.ParseDuration(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("delete_version_after", t.Seconds()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// kvSecretV2MetadataDelete resets the secret's metadata, deleting the
+// metadata would also delete all versions of the secret's data.
+func kvSecretV2MetadataDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"max_versions":             0,
+		"cas_required":             false,
+		"delete_version_after":     0,
+		consts.FieldCustomMetadata: map[string]interface{}{},
+	}
+
+	log.Printf("[DEBUG] Resetting secret metadata at %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error resetting secret metadata at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Reset secret metadata at %q", path)
+
+	return nil
+}
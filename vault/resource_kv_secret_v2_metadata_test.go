@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretV2Metadata(t *testing.T) {
+	resourceName := "vault_kv_secret_v2_metadata.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2MetadataConfig(mount, name, 5, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, fmt.Sprintf("%s/metadata/%s", mount, name)),
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "5"),
+					resource.TestCheckResourceAttr(resourceName, "cas_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.foo", "bar"),
+				),
+			},
+			{
+				// an application writes the secret's data
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					path := getKVV2Path(mount, name, consts.FieldData)
+					if _, err := client.Logical().Write(path, map[string]interface{}{
+						"data":    map[string]interface{}{"password": "s3cr3t"},
+						"options": map[string]interface{}{"cas": 0},
+					}); err != nil {
+						t.Fatalf("failed to write secret data to %q, err=%s", path, err)
+					}
+				},
+				Config: testKVSecretV2MetadataConfig(mount, name, 10, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "10"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.foo", "baz"),
+					testKVSecretV2MetadataCheckData(mount, name),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+			{
+				// the secret's data is kept when the metadata resource is destroyed
+				Config: kvV2MountConfig(mount),
+				Check:  testKVSecretV2MetadataCheckData(mount, name),
+			},
+		},
+	})
+}
+
+func testKVSecretV2MetadataCheckData(mount, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+		path := getKVV2Path(mount, name, consts.FieldData)
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("expected secret data at %q", path)
+		}
+
+		return nil
+	}
+}
+
+func testKVSecretV2MetadataConfig(mount, name string, maxVersions int, foo string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2_metadata" "test" {
+  mount                = vault_mount.kvv2.path
+  name                 = "%s"
+  max_versions         = %d
+  cas_required         = true
+  delete_version_after = 3600
+  custom_metadata = {
+    foo = "%s"
+  }
+}
+`, kvV2MountConfig(mount), name, maxVersions, foo)
+}
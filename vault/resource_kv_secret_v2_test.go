@@ -2,12 +2,15 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
 
@@ -70,3 +73,159 @@ resource "vault_kv_secret_v2" "test" {
 
 	return ret
 }
+
+func TestAccKVSecretV2_patch(t *testing.T) {
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.9") {
+				t.Skip("patch mode requires Vault 1.9 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				// an application writes its own keys to the secret
+				Config: kvV2MountConfig(mount),
+				Check: func(_ *terraform.State) error {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					_, err := client.Logical().Write(getKVV2Path(mount, name, consts.FieldData),
+						map[string]interface{}{
+							"data": map[string]interface{}{"app": "owned"},
+						})
+					return err
+				},
+			},
+			{
+				Config: testKVSecretV2PatchConfig(mount, name, `{ foo = "bar", zip = "zap" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPatch, "true"),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					testKVSecretV2CheckData(mount, name, map[string]interface{}{
+						"app": "owned", "foo": "bar", "zip": "zap",
+					}),
+				),
+			},
+			{
+				Config: testKVSecretV2PatchConfig(mount, name, `{ foo = "baz" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.foo", "baz"),
+					testKVSecretV2CheckData(mount, name, map[string]interface{}{
+						"app": "owned", "foo": "baz",
+					}),
+				),
+			},
+			{
+				Config: kvV2MountConfig(mount),
+				Check: testKVSecretV2CheckData(mount, name, map[string]interface{}{
+					"app": "owned",
+				}),
+			},
+		},
+	})
+}
+
+func testKVSecretV2CheckData(mount, name string, expected map[string]interface{}) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+		path := getKVV2Path(mount, name, consts.FieldData)
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("expected secret data at %q", path)
+		}
+
+		if !reflect.DeepEqual(expected, resp.Data["data"]) {
+			return fmt.Errorf("expected secret data %#v, got %#v", expected, resp.Data["data"])
+		}
+
+		return nil
+	}
+}
+
+func testKVSecretV2PatchConfig(mount, name, data string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  patch     = true
+  data_json = jsonencode(%s)
+}
+`, kvV2MountConfig(mount), name, data)
+}
+
+func TestAccKVSecretV2_readMetadataOnly(t *testing.T) {
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2ReadMetadataOnlyConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldReadMetadataOnly, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "0"),
+				),
+			},
+			{
+				// the secret is changed outside of Terraform
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					_, err := client.Logical().Write(getKVV2Path(mount, name, consts.FieldData),
+						map[string]interface{}{
+							"data": map[string]interface{}{"foo": "changed"},
+						})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKVSecretV2ReadMetadataOnlyConfig(mount, name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKVSecretV2ReadMetadataOnlyConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "3"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "3"),
+					testKVSecretV2CheckData(mount, name, map[string]interface{}{
+						"foo": "bar",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testKVSecretV2ReadMetadataOnlyConfig(mount, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount              = vault_mount.kvv2.path
+  name               = "%s"
+  read_metadata_only = true
+  data_json = jsonencode({
+    foo = "bar"
+  })
+}
+`, kvV2MountConfig(mount), name)
+}
@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	kvVersionsActionDelete  = "delete"
+	kvVersionsActionDestroy = "destroy"
+)
+
+func kvSecretV2VersionsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kvSecretV2VersionsCreate,
+		DeleteContext: kvSecretV2VersionsDelete,
+		ReadContext:   ReadContextWrapper(kvSecretV2VersionsRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix. For example, for a secret at 'kvv2/data/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'",
+			},
+			consts.FieldVersions: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The versions of the secret to delete or destroy.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			consts.FieldAction: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  kvVersionsActionDelete,
+				Description: "Either 'delete' to soft delete the versions, they are " +
+					"undeleted when the resource is destroyed, or 'destroy' to permanently " +
+					"remove the versions' data.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{kvVersionsActionDelete, kvVersionsActionDestroy}, false),
+			},
+		},
+	}
+}
+
+func kvSecretV2VersionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+	action := d.Get(consts.FieldAction).(string)
+
+	path := getKVV2Path(mount, name, action)
+
+	data := map[string]interface{}{
+		consts.FieldVersions: d.Get(consts.FieldVersions).(*schema.Set).List(),
+	}
+
+	log.Printf("[DEBUG] Writing versions to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing versions to %s, err=%s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote versions to %q", path)
+
+	d.SetId(path)
+
+	return kvSecretV2VersionsRead(ctx, d, meta)
+}
+
+func kvSecretV2VersionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	path := getKVV2Path(mount, name, consts.FieldMetadata)
+
+	log.Printf("[DEBUG] Reading %s from Vault", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading from Vault: %s", err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] secret metadata (%s) not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// remove the resource from state if any of the versions was restored
+	// out of band, so that the action is applied again.
+	versions, _ := resp.Data[consts.FieldVersions].(map[string]interface{})
+	destroy := d.Get(consts.FieldAction).(string) == kvVersionsActionDestroy
+	for _, v := range d.Get(consts.FieldVersions).(*schema.Set).List() {
+		version, ok := versions[strconv.Itoa(v.(int))].(map[string]interface{})
+		if !ok {
+			// versions removed by max_versions are gone for good
+			continue
+		}
+
+		applied := version["deletion_time"] != ""
+		if destroy {
+			applied = version["destroyed"] == true
+		}
+
+		if !applied {
+			log.Printf("[WARN] %s of version %d of %s was reverted, removing from state",
+				d.Get(consts.FieldAction), v.(int), path)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// kvSecretV2VersionsDelete undeletes soft deleted versions, destroyed
+// versions cannot be restored.
+func kvSecretV2VersionsDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get(consts.FieldAction).(string) == kvVersionsActionDestroy {
+		log.Printf("[DEBUG] Removing destroyed versions %q from state", d.Id())
+		return nil
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	path := getKVV2Path(mount, name, "undelete")
+
+	data := map[string]interface{}{
+		consts.FieldVersions: d.Get(consts.FieldVersions).(*schema.Set).List(),
+	}
+
+	log.Printf("[DEBUG] Undeleting versions at %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error undeleting versions at %s, err=%s", path, err)
+	}
+	log.Printf("[DEBUG] Undeleted versions at %q", path)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretV2Versions(t *testing.T) {
+	resourceName := "vault_kv_secret_v2_versions.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// an application writes two versions of the secret
+				Config: kvV2MountConfig(mount),
+				Check: func(_ *terraform.State) error {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					path := getKVV2Path(mount, name, consts.FieldData)
+					for _, v := range []string{"bar", "baz"} {
+						if _, err := client.Logical().Write(path, map[string]interface{}{
+							"data": map[string]interface{}{"foo": v},
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			},
+			{
+				Config: testKVSecretV2VersionsConfig(mount, name, "delete"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAction, "delete"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
+					testKVSecretV2VersionsCheck(mount, name, true, false),
+				),
+			},
+			{
+				// the deleted version is restored when switching the action
+				Config: testKVSecretV2VersionsConfig(mount, name, "destroy"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAction, "destroy"),
+					testKVSecretV2VersionsCheck(mount, name, false, true),
+				),
+			},
+		},
+	})
+}
+
+func testKVSecretV2VersionsCheck(mount, name string, deleted, destroyed bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+		path := getKVV2Path(mount, name, consts.FieldMetadata)
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			return fmt.Errorf("expected secret metadata at %q", path)
+		}
+
+		versions := resp.Data[consts.FieldVersions].(map[string]interface{})
+		version := versions["1"].(map[string]interface{})
+		if actual := version["deletion_time"] != ""; actual != deleted && !destroyed {
+			return fmt.Errorf("expected version 1 deleted=%t, got %t", deleted, actual)
+		}
+
+		if actual := version["destroyed"] == true; actual != destroyed {
+			return fmt.Errorf("expected version 1 destroyed=%t, got %t", destroyed, actual)
+		}
+
+		return nil
+	}
+}
+
+func testKVSecretV2VersionsConfig(mount, name, action string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2_versions" "test" {
+  mount    = vault_mount.kvv2.path
+  name     = "%s"
+  versions = [1]
+  action   = "%s"
+}
+`, kvV2MountConfig(mount), name, action)
+}
@@ -0,0 +1,256 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var (
+	ldapSecretBackendConfigFields = []string{
+		consts.FieldBindDN,
+		consts.FieldBindPass,
+		consts.FieldURL,
+		consts.FieldPasswordPolicy,
+		consts.FieldSchema,
+		consts.FieldUserDN,
+		consts.FieldUserAttr,
+		consts.FieldUPNDomain,
+		consts.FieldStartTLS,
+		consts.FieldInsecureTLS,
+		consts.FieldCertificate,
+		consts.FieldClientTLSCert,
+		consts.FieldClientTLSKey,
+		consts.FieldConnectionTimeout,
+		consts.FieldRequestTimeout,
+	}
+
+	// ldapSecretBackendConfigSensitiveFields can't be read from the API.
+	ldapSecretBackendConfigSensitiveFields = map[string]bool{
+		consts.FieldBindPass:     true,
+		consts.FieldClientTLSKey: true,
+	}
+)
+
+func ldapSecretBackendResource() *schema.Resource {
+	resource := &schema.Resource{
+		CreateContext: MountCreateContextWrapper(ldapSecretBackendCreateUpdate, provider.VaultVersion112),
+		ReadContext:   ReadContextWrapper(ldapSecretBackendRead),
+		UpdateContext: ldapSecretBackendCreateUpdate,
+		DeleteContext: ldapSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldBindDN: {
+				Type:        schema.TypeString,
+				Description: "Distinguished name of the object to bind as when managing passwords.",
+				Required:    true,
+			},
+			consts.FieldBindPass: {
+				Type:        schema.TypeString,
+				Description: "Password to use along with binddn when managing passwords.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			consts.FieldURL: {
+				Type:        schema.TypeString,
+				Description: "The LDAP server to connect to, multiple URLs can be provided as a comma separated list.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldPasswordPolicy: {
+				Type:        schema.TypeString,
+				Description: "Name of the password policy to use to generate passwords.",
+				Optional:    true,
+			},
+			consts.FieldSchema: {
+				Type:         schema.TypeString,
+				Description:  "The LDAP schema to use when storing entry passwords, one of openldap, ad or racf.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"openldap", "ad", "racf"}, false),
+			},
+			consts.FieldUserDN: {
+				Type:        schema.TypeString,
+				Description: "The base DN under which to perform user search.",
+				Optional:    true,
+			},
+			consts.FieldUserAttr: {
+				Type:        schema.TypeString,
+				Description: "The attribute field name used to perform user search in library management and static roles.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldUPNDomain: {
+				Type:        schema.TypeString,
+				Description: "The userPrincipalDomain used to construct the UPN string for the authenticating user.",
+				Optional:    true,
+			},
+			consts.FieldStartTLS: {
+				Type:        schema.TypeBool,
+				Description: "Issue a StartTLS command after establishing an unencrypted connection.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldInsecureTLS: {
+				Type:        schema.TypeBool,
+				Description: "Skip LDAP server SSL certificate verification.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Description: "CA certificate to use when verifying the LDAP server certificate, must be x509 PEM encoded.",
+				Optional:    true,
+			},
+			consts.FieldClientTLSCert: {
+				Type:        schema.TypeString,
+				Description: "Client certificate to provide to the LDAP server, must be x509 PEM encoded.",
+				Optional:    true,
+			},
+			consts.FieldClientTLSKey: {
+				Type:        schema.TypeString,
+				Description: "Client certificate key to provide to the LDAP server, must be x509 PEM encoded.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			consts.FieldConnectionTimeout: {
+				Type:        schema.TypeInt,
+				Description: "Timeout, in seconds, when attempting to connect to the LDAP server before trying the next URL.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldRequestTimeout: {
+				Type:        schema.TypeInt,
+				Description: "Timeout, in seconds, for the connection when making requests against the server before returning back an error.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldSkipStaticRoleImportRotation: {
+				Type:        schema.TypeBool,
+				Description: "Skip the rotation of the passwords of existing accounts when static roles are created. Requires Vault 1.16 or later.",
+				Optional:    true,
+			},
+		},
+	}
+
+	// Add common mount schema to the resource
+	provider.MustAddSchema(resource, getMountSchema("type"))
+
+	return resource
+}
+
+func ldapSecretBackendCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var path string
+	if d.IsNewResource() {
+		path = d.Get(consts.FieldPath).(string)
+		if err := createMount(d, client, path, consts.MountTypeLDAP); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := updateMount(d, meta, true); err != nil {
+			return diag.FromErr(err)
+		}
+		path = d.Id()
+	}
+	d.SetId(path)
+
+	data := make(map[string]interface{})
+	for _, k := range ldapSecretBackendConfigFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	if v, ok := d.GetOk(consts.FieldSkipStaticRoleImportRotation); ok || d.HasChange(consts.FieldSkipStaticRoleImportRotation) {
+		if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+			return diag.Errorf("%q requires Vault %s or later",
+				consts.FieldSkipStaticRoleImportRotation, provider.VaultVersion116)
+		}
+		data[consts.FieldSkipStaticRoleImportRotation] = v
+	}
+
+	configPath := fmt.Sprintf("%s/config", path)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return diag.Errorf(`error writing LDAP secrets backend config %q, err=%s`,
+			configPath, err)
+	}
+
+	return ldapSecretBackendRead(ctx, d, meta)
+}
+
+func ldapSecretBackendRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	resp, err := client.Logical().Read(path + "/config")
+	if err != nil {
+		return diag.Errorf("error reading LDAP secrets backend at %s/config: err=%s",
+			path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] LDAP secrets backend config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range ldapSecretBackendConfigFields {
+		if ldapSecretBackendConfigSensitiveFields[k] {
+			continue
+		}
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("error setting state key %q on LDAP secrets backend config, err=%s",
+					k, err)
+			}
+		}
+	}
+
+	if v, ok := resp.Data[consts.FieldSkipStaticRoleImportRotation]; ok {
+		if err := d.Set(consts.FieldSkipStaticRoleImportRotation, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := readMount(d, meta, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	if err := client.Sys().Unmount(path); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", path)
+			d.SetId("")
+		}
+		return diag.Errorf("error unmounting LDAP secrets backend from %q, err=%s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted LDAP secrets backend at %q", path)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var ldapSecretBackendDynamicRoleRegex = regexp.MustCompile("^(.+)/role/(.+)$")
+
+var ldapSecretBackendDynamicRoleFields = []string{
+	consts.FieldCreationLDIF,
+	consts.FieldDeletionLDIF,
+	consts.FieldRollbackLDIF,
+	consts.FieldUsernameTemplate,
+	consts.FieldDefaultTTL,
+	consts.FieldMaxTTL,
+}
+
+func ldapSecretBackendDynamicRoleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(ldapSecretBackendDynamicRoleWrite, provider.VaultVersion112),
+		ReadContext:   ReadContextWrapper(ldapSecretBackendDynamicRoleRead),
+		UpdateContext: ldapSecretBackendDynamicRoleWrite,
+		DeleteContext: ldapSecretBackendDynamicRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Description: "The mount path of the LDAP secrets engine.",
+				Required:    true,
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldRoleName: {
+				Type:        schema.TypeString,
+				Description: "The name of the dynamic role.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			consts.FieldCreationLDIF: {
+				Type:        schema.TypeString,
+				Description: "A templatized LDIF string used to create the user account, and any other entries.",
+				Required:    true,
+			},
+			consts.FieldDeletionLDIF: {
+				Type:        schema.TypeString,
+				Description: "A templatized LDIF string used to delete the user account, and any other entries.",
+				Required:    true,
+			},
+			consts.FieldRollbackLDIF: {
+				Type:        schema.TypeString,
+				Description: "A templatized LDIF string used to roll back the creation of the user account if it fails.",
+				Optional:    true,
+			},
+			consts.FieldUsernameTemplate: {
+				Type:        schema.TypeString,
+				Description: "A template used to generate the usernames of the dynamic user accounts.",
+				Optional:    true,
+			},
+			consts.FieldDefaultTTL: {
+				Type:        schema.TypeInt,
+				Description: "The default TTL of the generated credentials, in seconds.",
+				Optional:    true,
+			},
+			consts.FieldMaxTTL: {
+				Type:        schema.TypeInt,
+				Description: "The maximum TTL of the generated credentials, in seconds.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func ldapSecretBackendDynamicRoleWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := ldapSecretBackendDynamicRolePath(d.Get(consts.FieldMount).(string), d.Get(consts.FieldRoleName).(string))
+	data := make(map[string]interface{})
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing LDAP dynamic role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP dynamic role %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendDynamicRoleRead(ctx, d, meta)
+}
+
+func ldapSecretBackendDynamicRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	res := ldapSecretBackendDynamicRoleRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return diag.Errorf("invalid LDAP dynamic role ID %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP dynamic role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading LDAP dynamic role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] LDAP dynamic role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldMount, res[1]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldRoleName, res[2]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on LDAP dynamic role, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP dynamic role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP dynamic role %q", path)
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRolePath(mount, name string) string {
+	return fmt.Sprintf("%s/role/%s", strings.Trim(mount, "/"), name)
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+const testLDAPSecretBackendDynamicRoleCreationLDIF = `dn: cn={{.Username}},ou=users,dc=example,dc=com
+objectClass: person
+objectClass: top
+cn: learn
+sn: {{.Password | utf16le | base64}}
+memberOf: cn=dev,ou=groups,dc=example,dc=com
+userPassword: {{.Password}}
+`
+
+const testLDAPSecretBackendDynamicRoleDeletionLDIF = `dn: cn={{.Username}},ou=users,dc=example,dc=com
+changetype: delete
+`
+
+func TestAccLDAPSecretBackendDynamicRole(t *testing.T) {
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+
+	mount := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	resourceName := "vault_ldap_secret_backend_dynamic_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendDynamicRoleConfig(mount, bindDN, bindPass, url, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCreationLDIF, testLDAPSecretBackendDynamicRoleCreationLDIF),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDeletionLDIF, testLDAPSecretBackendDynamicRoleDeletionLDIF),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRollbackLDIF, ""),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldUsernameTemplate, ""),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultTTL, "0"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "0"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendDynamicRoleConfig(mount, bindDN, bindPass, url, name, `
+  rollback_ldif     = local.deletion_ldif
+  username_template = "v_{{.RoleName}}_{{random 10}}"
+  default_ttl       = 3600
+  max_ttl           = 7200
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRollbackLDIF, testLDAPSecretBackendDynamicRoleDeletionLDIF),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldUsernameTemplate, "v_{{.RoleName}}_{{random 10}}"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "7200"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testLDAPSecretBackendDynamicRoleConfig(mount, bindDN, bindPass, url, name, extra string) string {
+	return fmt.Sprintf(`
+locals {
+  creation_ldif = <<EOT
+%s
+EOT
+  deletion_ldif = <<EOT
+%s
+EOT
+}
+
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  binddn   = "%s"
+  bindpass = "%s"
+  url      = "%s"
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  mount         = vault_ldap_secret_backend.test.path
+  role_name     = "%s"
+  creation_ldif = local.creation_ldif
+  deletion_ldif = local.deletion_ldif
+%s
+}
+`, strings.TrimSuffix(testLDAPSecretBackendDynamicRoleCreationLDIF, "\n"),
+		strings.TrimSuffix(testLDAPSecretBackendDynamicRoleDeletionLDIF, "\n"),
+		mount, bindDN, bindPass, url, name, extra)
+}
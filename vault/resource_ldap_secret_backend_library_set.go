@@ -0,0 +1,161 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var ldapSecretBackendLibrarySetRegex = regexp.MustCompile("^(.+)/library/(.+)$")
+
+var ldapSecretBackendLibrarySetFields = []string{
+	consts.FieldServiceAccountNames,
+	consts.FieldTTL,
+	consts.FieldMaxTTL,
+	consts.FieldDisableCheckInEnforcement,
+}
+
+func ldapSecretBackendLibrarySetResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(ldapSecretBackendLibrarySetWrite, provider.VaultVersion112),
+		ReadContext:   ReadContextWrapper(ldapSecretBackendLibrarySetRead),
+		UpdateContext: ldapSecretBackendLibrarySetWrite,
+		DeleteContext: ldapSecretBackendLibrarySetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Description: "The mount path of the LDAP secrets engine.",
+				Required:    true,
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Description: "The name of the set of service accounts.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			consts.FieldServiceAccountNames: {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of all the service accounts that can be checked out from this set. These service accounts must already exist in the LDAP directory.",
+				Required:    true,
+			},
+			consts.FieldTTL: {
+				Type:        schema.TypeInt,
+				Description: "The amount of time, in seconds, a single check-out lasts before Vault automatically checks it back in.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldMaxTTL: {
+				Type:        schema.TypeInt,
+				Description: "The maximum amount of time, in seconds, a check-out lasts with renewal before Vault automatically checks it back in.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldDisableCheckInEnforcement: {
+				Type:        schema.TypeBool,
+				Description: "Disable enforcing that service accounts must be checked in by the entity or client token that checked them out.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func ldapSecretBackendLibrarySetWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := ldapSecretBackendLibrarySetPath(d.Get(consts.FieldMount).(string), d.Get(consts.FieldName).(string))
+	data := make(map[string]interface{})
+	for _, k := range ldapSecretBackendLibrarySetFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing LDAP library set %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing LDAP library set %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP library set %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendLibrarySetRead(ctx, d, meta)
+}
+
+func ldapSecretBackendLibrarySetRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	res := ldapSecretBackendLibrarySetRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return diag.Errorf("invalid LDAP library set ID %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP library set %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading LDAP library set %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] LDAP library set %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldMount, res[1]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldName, res[2]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range ldapSecretBackendLibrarySetFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on LDAP library set, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendLibrarySetDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP library set %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting LDAP library set %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP library set %q", path)
+
+	return nil
+}
+
+func ldapSecretBackendLibrarySetPath(mount, name string) string {
+	return fmt.Sprintf("%s/library/%s", strings.Trim(mount, "/"), name)
+}
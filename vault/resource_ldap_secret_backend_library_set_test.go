@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccLDAPSecretBackendLibrarySet(t *testing.T) {
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+	values := testutil.SkipTestEnvUnset(t, "LDAP_LIBRARY_SERVICE_ACCOUNTS")
+	serviceAccounts := strings.Split(values[0], ",")
+
+	mount := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("tf-test-library")
+	resourceName := "vault_ldap_secret_backend_library_set.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendLibrarySetConfig(mount, bindDN, bindPass, url, name, serviceAccounts[:1], 3600, 7200, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.0", serviceAccounts[0]),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "7200"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDisableCheckInEnforcement, "false"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendLibrarySetConfig(mount, bindDN, bindPass, url, name, serviceAccounts, 1800, 3600, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.#", fmt.Sprint(len(serviceAccounts))),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTTL, "1800"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDisableCheckInEnforcement, "true"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testLDAPSecretBackendLibrarySetConfig(mount, bindDN, bindPass, url, name string, serviceAccounts []string, ttl, maxTTL int, disableCheckIn bool) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  binddn   = "%s"
+  bindpass = "%s"
+  url      = "%s"
+}
+
+resource "vault_ldap_secret_backend_library_set" "test" {
+  mount                        = vault_ldap_secret_backend.test.path
+  name                         = "%s"
+  service_account_names        = ["%s"]
+  ttl                          = %d
+  max_ttl                      = %d
+  disable_check_in_enforcement = %t
+}
+`, mount, bindDN, bindPass, url, name, strings.Join(serviceAccounts, `", "`), ttl, maxTTL, disableCheckIn)
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var ldapSecretBackendStaticRoleRegex = regexp.MustCompile("^(.+)/static-role/(.+)$")
+
+var ldapSecretBackendStaticRoleFields = []string{
+	consts.FieldUsername,
+	consts.FieldDN,
+	consts.FieldRotationPeriod,
+}
+
+func ldapSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(ldapSecretBackendStaticRoleWrite, provider.VaultVersion112),
+		ReadContext:   ReadContextWrapper(ldapSecretBackendStaticRoleRead),
+		UpdateContext: ldapSecretBackendStaticRoleWrite,
+		DeleteContext: ldapSecretBackendStaticRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Description: "The mount path of the LDAP secrets engine.",
+				Required:    true,
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldRoleName: {
+				Type:        schema.TypeString,
+				Description: "The name of the static role.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			consts.FieldUsername: {
+				Type:        schema.TypeString,
+				Description: "The username of the existing LDAP entry to manage password rotation for.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			consts.FieldDN: {
+				Type:        schema.TypeString,
+				Description: "Distinguished name of the existing LDAP entry to manage password rotation for.",
+				Optional:    true,
+			},
+			consts.FieldRotationPeriod: {
+				Type:        schema.TypeInt,
+				Description: "How often Vault should rotate the password of the user entry, in seconds.",
+				Required:    true,
+			},
+			consts.FieldSkipImportRotation: {
+				Type:        schema.TypeBool,
+				Description: "Skip the rotation of the password of the user entry when the role is created. Requires Vault 1.16 or later.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func ldapSecretBackendStaticRoleWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := ldapSecretBackendStaticRolePath(d.Get(consts.FieldMount).(string), d.Get(consts.FieldRoleName).(string))
+	data := make(map[string]interface{})
+	for _, k := range ldapSecretBackendStaticRoleFields {
+		data[k] = d.Get(k)
+	}
+
+	// only applies to the creation of the role
+	if d.IsNewResource() {
+		if v, ok := d.GetOk(consts.FieldSkipImportRotation); ok {
+			if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+				return diag.Errorf("%q requires Vault %s or later",
+					consts.FieldSkipImportRotation, provider.VaultVersion116)
+			}
+			data[consts.FieldSkipImportRotation] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing LDAP static role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing LDAP static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP static role %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendStaticRoleRead(ctx, d, meta)
+}
+
+func ldapSecretBackendStaticRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	res := ldapSecretBackendStaticRoleRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return diag.Errorf("invalid LDAP static role ID %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading LDAP static role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] LDAP static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldMount, res[1]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldRoleName, res[2]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range ldapSecretBackendStaticRoleFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on LDAP static role, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendStaticRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP static role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting LDAP static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP static role %q", path)
+
+	return nil
+}
+
+func ldapSecretBackendStaticRolePath(mount, name string) string {
+	return fmt.Sprintf("%s/static-role/%s", strings.Trim(mount, "/"), name)
+}
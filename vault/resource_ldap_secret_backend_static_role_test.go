@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccLDAPSecretBackendStaticRole(t *testing.T) {
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+	values := testutil.SkipTestEnvUnset(t, "LDAP_STATIC_USERNAME", "LDAP_STATIC_DN")
+	username, dn := values[0], values[1]
+
+	mount := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	resourceName := "vault_ldap_secret_backend_static_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendStaticRoleConfig(mount, bindDN, bindPass, url, name, username, dn, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldUsername, username),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDN, dn),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRotationPeriod, "3600"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendStaticRoleConfig(mount, bindDN, bindPass, url, name, username, dn, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldUsername, username),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRotationPeriod, "7200"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testLDAPSecretBackendStaticRoleConfig(mount, bindDN, bindPass, url, name, username, dn string, rotationPeriod int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  binddn   = "%s"
+  bindpass = "%s"
+  url      = "%s"
+}
+
+resource "vault_ldap_secret_backend_static_role" "test" {
+  mount           = vault_ldap_secret_backend.test.path
+  role_name       = "%s"
+  username        = "%s"
+  dn              = "%s"
+  rotation_period = %d
+}
+`, mount, bindDN, bindPass, url, name, username, dn, rotationPeriod)
+}
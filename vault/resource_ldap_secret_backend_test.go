@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccLDAPSecretBackend(t *testing.T) {
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+
+	path := acctest.RandomWithPrefix("tf-test-ldap")
+	resourceType := "vault_ldap_secret_backend"
+	resourceName := resourceType + ".test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed(resourceType, consts.MountTypeLDAP, ""),
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackend_initialConfig(path, bindDN, bindPass, url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBindDN, bindDN),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldURL, url),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSchema, "openldap"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldInsecureTLS, "false"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackend_updateConfig(path, bindDN, bindPass, url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDescription, "ldap secrets engine"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultLeaseTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBindDN, bindDN),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldURL, url),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldUserDN, "ou=users,dc=example,dc=com"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldInsecureTLS, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRequestTimeout, "60"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil, consts.FieldBindPass),
+		},
+	})
+}
+
+func testLDAPSecretBackend_initialConfig(path, bindDN, bindPass, url string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path     = "%s"
+  binddn   = "%s"
+  bindpass = "%s"
+  url      = "%s"
+}`, path, bindDN, bindPass, url)
+}
+
+func testLDAPSecretBackend_updateConfig(path, bindDN, bindPass, url string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  path                      = "%s"
+  description               = "ldap secrets engine"
+  default_lease_ttl_seconds = "3600"
+  binddn                    = "%s"
+  bindpass                  = "%s"
+  url                       = "%s"
+  userdn                    = "ou=users,dc=example,dc=com"
+  insecure_tls              = true
+  request_timeout           = 60
+}`, path, bindDN, bindPass, url)
+}
@@ -19,6 +19,7 @@ const (
 	kmsTypePKCS  = "pkcs11"
 	kmsTypeAWS   = "awskms"
 	kmsTypeAzure = "azurekeyvault"
+	kmsTypeGCP   = "gcpckms"
 )
 
 type managedKeysConfig struct {
@@ -46,10 +47,17 @@ var (
 		schemaFunc:   managedKeysPKCSConfigSchema,
 	}
 
+	managedKeysGCPConfig = &managedKeysConfig{
+		providerType: consts.FieldGCP,
+		keyType:      kmsTypeGCP,
+		schemaFunc:   managedKeysGCPConfigSchema,
+	}
+
 	managedKeyProviders = []*managedKeysConfig{
 		managedKeysAWSConfig,
 		managedKeysAzureConfig,
 		managedKeysPKCSConfig,
+		managedKeysGCPConfig,
 	}
 )
 
@@ -101,6 +109,15 @@ func managedKeysResource() *schema.Resource {
 				},
 				Set: hashManagedKeys,
 			},
+			managedKeysGCPConfig.providerType: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block for GCP Cloud KMS Managed Keys",
+				Elem: &schema.Resource{
+					Schema: managedKeysGCPConfig.schemaFunc(),
+				},
+				Set: hashManagedKeys,
+			},
 		},
 	}
 }
@@ -205,6 +222,7 @@ func managedKeysPKCSConfigSchema() schemaMap {
 		consts.FieldPin: {
 			Type:        schema.TypeString,
 			Required:    true,
+			Sensitive:   true,
 			Description: "The PIN for login",
 		},
 		consts.FieldSlot: {
@@ -239,6 +257,13 @@ func managedKeysPKCSConfigSchema() schemaMap {
 			Description: "Force all operations to open up a read-write session " +
 				"to the HSM",
 		},
+		consts.FieldMaxParallel: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "The maximum number of concurrent requests to the HSM, " +
+				"specified as a string in a decimal format",
+		},
 	}
 
 	return setCommonManagedKeysSchema(s)
@@ -362,6 +387,52 @@ func managedKeysAzureConfigSchema() schemaMap {
 	return setCommonManagedKeysSchema(s)
 }
 
+func managedKeysGCPConfigSchema() schemaMap {
+	s := schemaMap{
+		consts.FieldName: {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "A unique lowercase name that serves as " +
+				"identifying the key",
+		},
+		consts.FieldCredentials: {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			Description: "The JSON credentials of the service account used to query " +
+				"the Cloud KMS API, defaults to the credentials of the Vault server",
+		},
+		consts.FieldProject: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The GCP project ID of the key ring",
+		},
+		consts.FieldRegion: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The GCP region of the key ring",
+		},
+		consts.FieldKeyRing: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Cloud KMS key ring",
+		},
+		consts.FieldCryptoKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Cloud KMS crypto key",
+		},
+		consts.FieldAlgorithm: {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "The signature algorithm of the crypto key, " +
+				"e.g. 'ec_sign_p256_sha256'",
+		},
+	}
+
+	return setCommonManagedKeysSchema(s)
+}
+
 func getManagedKeysConfigData(config map[string]interface{}, sm schemaMap) (string, map[string]interface{}) {
 	data := map[string]interface{}{}
 	var name string
@@ -502,6 +573,12 @@ func createUpdateManagedKeys(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if _, ok := d.GetOk(consts.FieldGCP); ok {
+		if diags := writeManagedKeysData(d, client, consts.FieldGCP); diags != nil {
+			return diags
+		}
+	}
+
 	// set ID to 'default'
 	d.SetId("default")
 
@@ -631,6 +708,16 @@ func readPKCSManagedKeys(d *schema.ResourceData, client *api.Client) error {
 	return nil
 }
 
+func readGCPManagedKeys(d *schema.ResourceData, client *api.Client) error {
+	redacted := []string{"credentials"}
+	if err := readAndSetManagedKeys(d, client, consts.FieldGCP,
+		map[string]string{consts.FieldUUID: "UUID"}, redacted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func readManagedKeys(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -660,6 +747,13 @@ func readManagedKeys(_ context.Context, d *schema.ResourceData, meta interface{}
 		})
 	}
 
+	if err := readGCPManagedKeys(d, client); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to read GCP Managed Keys, err=%s", err),
+		})
+	}
+
 	return diags
 }
 
@@ -721,5 +815,11 @@ func deleteManagedKeys(_ context.Context, d *schema.ResourceData, meta interface
 		}
 	}
 
+	if _, ok := d.GetOk(consts.FieldGCP); ok {
+		if diags := deleteManagedKeyType(client, kmsTypeGCP); diags != nil {
+			return diags
+		}
+	}
+
 	return nil
 }
@@ -193,3 +193,68 @@ resource "vault_managed_keys" "test" {
 }
 `, name)
 }
+
+func TestManagedKeys_gcp(t *testing.T) {
+	name := acctest.RandomWithPrefix("gcp-keys")
+	resourceName := "vault_managed_keys.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("GCP Cloud KMS managed keys require Vault 1.12 or later")
+			}
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testManagedKeysConfig_gcp(name, "ec_sign_p256_sha256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "gcp.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "gcp.*",
+						map[string]string{
+							consts.FieldName:      name,
+							consts.FieldProject:   "test-project",
+							consts.FieldRegion:    "us-east1",
+							consts.FieldKeyRing:   "test-key-ring",
+							consts.FieldCryptoKey: "test-crypto-key",
+							consts.FieldAlgorithm: "ec_sign_p256_sha256",
+						},
+					),
+				),
+			},
+			{
+				Config: testManagedKeysConfig_gcp(name, "rsa_sign_pss_2048_sha256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "gcp.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "gcp.*",
+						map[string]string{
+							consts.FieldName:      name,
+							consts.FieldAlgorithm: "rsa_sign_pss_2048_sha256",
+						},
+					),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testManagedKeysConfig_gcp(name, algorithm string) string {
+	return fmt.Sprintf(`
+resource "vault_managed_keys" "test" {
+  gcp {
+    name       = "%s"
+    project    = "test-project"
+    region     = "us-east1"
+    key_ring   = "test-key-ring"
+    crypto_key = "test-crypto-key"
+    algorithm  = "%s"
+  }
+}
+`, name, algorithm)
+}
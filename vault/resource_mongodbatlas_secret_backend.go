@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const (
+	fieldMongoDBAtlasPublicKey  = "public_key"
+	fieldMongoDBAtlasPrivateKey = "private_key"
+)
+
+func mongodbAtlasSecretBackendResource() *schema.Resource {
+	resource := &schema.Resource{
+		CreateContext: mongodbAtlasSecretBackendCreateUpdate,
+		ReadContext:   ReadContextWrapper(mongodbAtlasSecretBackendRead),
+		UpdateContext: mongodbAtlasSecretBackendCreateUpdate,
+		DeleteContext: mongodbAtlasSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			fieldMongoDBAtlasPublicKey: {
+				Type:        schema.TypeString,
+				Description: "The public key of the MongoDB Atlas programmatic API key used by Vault.",
+				Required:    true,
+			},
+			fieldMongoDBAtlasPrivateKey: {
+				Type:        schema.TypeString,
+				Description: "The private key of the MongoDB Atlas programmatic API key used by Vault.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+
+	// Add common mount schema to the resource
+	provider.MustAddSchema(resource, getMountSchema("type"))
+
+	return resource
+}
+
+func mongodbAtlasSecretBackendCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var path string
+	if d.IsNewResource() {
+		path = d.Get(consts.FieldPath).(string)
+		if err := createMount(d, client, path, consts.MountTypeMongoDBAtlas); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := updateMount(d, meta, true); err != nil {
+			return diag.FromErr(err)
+		}
+		path = d.Id()
+	}
+	d.SetId(path)
+
+	// the API key pair must always be provided together on configuration updates.
+	data := map[string]interface{}{
+		fieldMongoDBAtlasPublicKey:  d.Get(fieldMongoDBAtlasPublicKey),
+		fieldMongoDBAtlasPrivateKey: d.Get(fieldMongoDBAtlasPrivateKey),
+	}
+
+	configPath := fmt.Sprintf("%s/config", path)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return diag.Errorf(`error writing MongoDB Atlas backend config %q, err=%s`,
+			configPath, err)
+	}
+
+	return mongodbAtlasSecretBackendRead(ctx, d, meta)
+}
+
+func mongodbAtlasSecretBackendRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	resp, err := client.Logical().Read(path + "/config")
+	if err != nil {
+		return diag.Errorf("error reading MongoDB Atlas backend at %s/config: err=%s",
+			path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] MongoDB Atlas config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	// fieldMongoDBAtlasPrivateKey can't be read from the API
+	if v, ok := resp.Data[fieldMongoDBAtlasPublicKey]; ok {
+		if err := d.Set(fieldMongoDBAtlasPublicKey, v); err != nil {
+			return diag.Errorf("error setting state key %q on MongoDB Atlas backend config, err=%s",
+				fieldMongoDBAtlasPublicKey, err)
+		}
+	}
+
+	if err := readMount(d, meta, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	if err := client.Sys().Unmount(path); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", path)
+			d.SetId("")
+		}
+		return diag.Errorf("error unmounting MongoDB Atlas backend from %q, err=%s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted MongoDB Atlas backend at %q", path)
+
+	return nil
+}
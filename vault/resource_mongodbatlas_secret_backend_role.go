@@ -0,0 +1,238 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var mongodbAtlasSecretBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+
+const (
+	fieldMongoDBAtlasOrganizationID = "organization_id"
+	fieldMongoDBAtlasProjectID      = "project_id"
+	fieldMongoDBAtlasRoles          = "roles"
+	fieldMongoDBAtlasProjectRoles   = "project_roles"
+	fieldMongoDBAtlasIPAddresses    = "ip_addresses"
+	fieldMongoDBAtlasCIDRBlocks     = "cidr_blocks"
+)
+
+var (
+	mongodbAtlasSecretBackendRoleFields = []string{
+		fieldMongoDBAtlasOrganizationID,
+		fieldMongoDBAtlasProjectID,
+		fieldMongoDBAtlasRoles,
+		fieldMongoDBAtlasProjectRoles,
+		fieldMongoDBAtlasIPAddresses,
+		fieldMongoDBAtlasCIDRBlocks,
+	}
+	mongodbAtlasSecretBackendRoleTTLFields = []string{
+		consts.FieldTTL,
+		consts.FieldMaxTTL,
+	}
+)
+
+func mongodbAtlasSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: mongodbAtlasSecretBackendRoleCreateUpdate,
+		ReadContext:   ReadContextWrapper(mongodbAtlasSecretBackendRoleRead),
+		UpdateContext: mongodbAtlasSecretBackendRoleCreateUpdate,
+		DeleteContext: mongodbAtlasSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Description: "The name of the role.",
+				ForceNew:    true,
+				Required:    true,
+			},
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Description: "The mount path for the MongoDB Atlas secrets engine.",
+				Required:    true,
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			fieldMongoDBAtlasOrganizationID: {
+				Type: schema.TypeString,
+				Description: "The ID of the Atlas organization to create the programmatic API key in. " +
+					"Exactly one of organization_id or project_id must be set.",
+				Optional: true,
+			},
+			fieldMongoDBAtlasProjectID: {
+				Type: schema.TypeString,
+				Description: "The ID of the Atlas project to create the programmatic API key in. " +
+					"Exactly one of organization_id or project_id must be set.",
+				Optional: true,
+			},
+			fieldMongoDBAtlasRoles: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The list of organization or project roles assigned to the programmatic API key, " +
+					"e.g. ORG_MEMBER or GROUP_READ_ONLY.",
+				Required: true,
+			},
+			fieldMongoDBAtlasProjectRoles: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The list of project roles assigned to an organization programmatic API key " +
+					"when it is also assigned to the project set in project_id.",
+				Optional: true,
+			},
+			fieldMongoDBAtlasIPAddresses: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IP addresses allowed to use the programmatic API key.",
+				Optional:    true,
+			},
+			fieldMongoDBAtlasCIDRBlocks: {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The CIDR blocks allowed to use the programmatic API key.",
+				Optional:    true,
+			},
+			consts.FieldTTL: {
+				Type:        schema.TypeInt,
+				Description: "The TTL of the programmatic API key in seconds.",
+				Optional:    true,
+				Computed:    true,
+			},
+			consts.FieldMaxTTL: {
+				Type:        schema.TypeInt,
+				Description: "The maximum TTL of the programmatic API key in seconds.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func mongodbAtlasSecretBackendRoleCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the role endpoint replaces the whole role, so every field is always sent
+	data := make(map[string]interface{})
+	for _, k := range mongodbAtlasSecretBackendRoleFields {
+		data[k] = d.Get(k)
+	}
+	for _, k := range mongodbAtlasSecretBackendRoleTTLFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	name := d.Get(consts.FieldName).(string)
+	backend := d.Get(consts.FieldBackend).(string)
+	rolePath := mongodbAtlasSecretBackendRolePath(backend, name)
+	if _, err := client.Logical().Write(rolePath, data); err != nil {
+		return diag.Errorf(`error writing MongoDB Atlas backend role %q, err=%s`,
+			rolePath, err)
+	}
+
+	d.SetId(rolePath)
+	return mongodbAtlasSecretBackendRoleRead(ctx, d, meta)
+}
+
+func mongodbAtlasSecretBackendRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading MongoDB Atlas backend role at %s: err=%s",
+			path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] MongoDB Atlas backend role not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	backend, err := mongodbAtlasSecretBackendFromPath(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldBackend, backend); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldName, path[strings.LastIndex(path, "/")+1:]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range mongodbAtlasSecretBackendRoleFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on MongoDB Atlas backend role, err=%s",
+				k, err)
+		}
+	}
+
+	for _, k := range mongodbAtlasSecretBackendRoleTTLFields {
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err := v.Int64()
+			if err != nil {
+				return diag.Errorf("unexpected value %q for %s of %q", v, k, path)
+			}
+			if err := d.Set(k, n); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := d.Id()
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting MongoDB Atlas backend role at %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + name
+}
+
+func mongodbAtlasSecretBackendFromPath(path string) (string, error) {
+	if !mongodbAtlasSecretBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := mongodbAtlasSecretBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
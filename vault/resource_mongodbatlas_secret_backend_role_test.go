@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccMongoDBAtlasSecretBackendRole(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "MONGODB_ATLAS_PUBLIC_KEY", "MONGODB_ATLAS_PRIVATE_KEY", "MONGODB_ATLAS_PROJECT_ID")
+	publicKey, privateKey, projectID := values[0], values[1], values[2]
+
+	resourceName := "vault_mongodbatlas_secret_backend_role.test"
+	backend := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	name := acctest.RandomWithPrefix("tf-test-role")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccMongoDBAtlasSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMongoDBAtlasSecretBackendRole_initialConfig(backend, publicKey, privateKey, name, projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasProjectID, projectID),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasRoles+".#", "1"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasRoles+".0", "GROUP_READ_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasIPAddresses+".#", "0"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasCIDRBlocks+".#", "0"),
+				),
+			},
+			{
+				Config: testMongoDBAtlasSecretBackendRole_updateConfig(backend, publicKey, privateKey, name, projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasProjectID, projectID),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasRoles+".#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasRoles+".0", "GROUP_READ_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasRoles+".1", "GROUP_DATA_ACCESS_READ_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasIPAddresses+".#", "1"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasIPAddresses+".0", "192.168.1.10"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasCIDRBlocks+".#", "1"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasCIDRBlocks+".0", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxTTL, "7200"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccMongoDBAtlasSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mongodbatlas_secret_backend_role" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for MongoDB Atlas secret backend role %q: %s",
+				rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("MongoDB Atlas secret backend role %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testMongoDBAtlasSecretBackendRole_initialConfig(backend, publicKey, privateKey, name, projectID string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path        = "%s"
+  public_key  = "%s"
+  private_key = "%s"
+}
+
+resource "vault_mongodbatlas_secret_backend_role" "test" {
+  backend    = vault_mongodbatlas_secret_backend.test.path
+  name       = "%s"
+  project_id = "%s"
+  roles      = ["GROUP_READ_ONLY"]
+}
+`, backend, publicKey, privateKey, name, projectID)
+}
+
+func testMongoDBAtlasSecretBackendRole_updateConfig(backend, publicKey, privateKey, name, projectID string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path        = "%s"
+  public_key  = "%s"
+  private_key = "%s"
+}
+
+resource "vault_mongodbatlas_secret_backend_role" "test" {
+  backend      = vault_mongodbatlas_secret_backend.test.path
+  name         = "%s"
+  project_id   = "%s"
+  roles        = ["GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"]
+  ip_addresses = ["192.168.1.10"]
+  cidr_blocks  = ["10.0.0.0/24"]
+  ttl          = 3600
+  max_ttl      = 7200
+}
+`, backend, publicKey, privateKey, name, projectID)
+}
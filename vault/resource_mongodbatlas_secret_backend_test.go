@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccMongoDBAtlasSecretBackend(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "MONGODB_ATLAS_PUBLIC_KEY", "MONGODB_ATLAS_PRIVATE_KEY")
+	publicKey, privateKey := values[0], values[1]
+
+	path := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	resourceType := "vault_mongodbatlas_secret_backend"
+	resourceName := resourceType + ".test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed(resourceType, consts.MountTypeMongoDBAtlas, ""),
+		Steps: []resource.TestStep{
+			{
+				Config: testMongoDBAtlasSecretBackend_initialConfig(path, publicKey, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultLeaseTTL, "0"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxLeaseTTL, "0"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasPublicKey, publicKey),
+				),
+			},
+			{
+				Config: testMongoDBAtlasSecretBackend_updateConfig(path, publicKey, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDescription, "mongodbatlas secrets engine"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultLeaseTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxLeaseTTL, "7200"),
+					resource.TestCheckResourceAttr(resourceName, fieldMongoDBAtlasPublicKey, publicKey),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{fieldMongoDBAtlasPrivateKey},
+			},
+		},
+	})
+}
+
+func testMongoDBAtlasSecretBackend_initialConfig(path, publicKey, privateKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path        = "%s"
+  public_key  = "%s"
+  private_key = "%s"
+}`, path, publicKey, privateKey)
+}
+
+func testMongoDBAtlasSecretBackend_updateConfig(path, publicKey, privateKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  path                      = "%s"
+  description               = "mongodbatlas secrets engine"
+  default_lease_ttl_seconds = "3600"
+  max_lease_ttl_seconds     = "7200"
+  public_key                = "%s"
+  private_key               = "%s"
+}`, path, publicKey, privateKey)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -112,10 +113,28 @@ func getMountSchema(excludes ...string) schemaMap {
 		"allowed_managed_keys": {
 			Type:        schema.TypeSet,
 			Optional:    true,
-			ForceNew:    true,
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "List of managed key registry entry names that the mount in question is allowed to access",
 		},
+
+		consts.FieldDelegatedAuthAccessors: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "List of allowed authentication mount accessors the backend can request delegated authentication for",
+		},
+
+		consts.FieldPluginVersion: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the semantic version of the plugin to use, e.g. 'v1.0.0'",
+		},
+
+		consts.FieldIdentityTokenKey: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The key to use for signing plugin workload identity tokens",
+		},
 	}
 	for _, v := range excludes {
 		delete(s, v)
@@ -124,16 +143,16 @@ func getMountSchema(excludes ...string) schemaMap {
 }
 
 func MountResource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddDeletionProtectionSchema(&schema.Resource{
 		Create: mountWrite,
 		Update: mountUpdate,
-		Delete: mountDelete,
+		Delete: DeletionProtectionWrapper(mountDelete),
 		Read:   ReadWrapper(mountRead),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Schema: getMountSchema(),
-	}
+	})
 }
 
 func mountWrite(d *schema.ResourceData, meta interface{}) error {
@@ -164,6 +183,7 @@ func createMount(d *schema.ResourceData, client *api.Client, path string, mountT
 		Options:               mountOptions(d),
 		SealWrap:              d.Get("seal_wrap").(bool),
 		ExternalEntropyAccess: d.Get("external_entropy_access").(bool),
+		PluginVersion:         d.Get(consts.FieldPluginVersion).(string),
 	}
 
 	if v, ok := d.GetOk("audit_non_hmac_request_keys"); ok {
@@ -183,6 +203,50 @@ func createMount(d *schema.ResourceData, client *api.Client, path string, mountT
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
+	if err := tuneMountExtendedConfig(d, client, path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// tuneMountExtendedConfig writes the tunable mount settings that are not
+// supported by api.MountConfigInput, only changed settings are written.
+func tuneMountExtendedConfig(d *schema.ResourceData, client *api.Client, path string) error {
+	data := map[string]interface{}{}
+	if d.HasChange(consts.FieldDelegatedAuthAccessors) {
+		data[consts.FieldDelegatedAuthAccessors] = expandStringSliceWithEmpty(
+			d.Get(consts.FieldDelegatedAuthAccessors).([]interface{}), true)
+	}
+
+	if d.HasChange(consts.FieldIdentityTokenKey) {
+		data[consts.FieldIdentityTokenKey] = d.Get(consts.FieldIdentityTokenKey)
+	}
+
+	// the plugin version is part of the mount input on create.
+	if !d.IsNewResource() && d.HasChange(consts.FieldPluginVersion) {
+		data[consts.FieldPluginVersion] = d.Get(consts.FieldPluginVersion)
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Writing extended config for mount %s in Vault", path)
+	if _, err := client.Logical().Write(mountTunePath(path), data); err != nil {
+		return fmt.Errorf("error writing extended config for mount %q: %s", path, err)
+	}
+
+	// a new plugin version only takes effect once the backend is reloaded.
+	if _, ok := data[consts.FieldPluginVersion]; ok {
+		log.Printf("[DEBUG] Reloading plugin for mount %s in Vault", path)
+		if _, err := client.Sys().ReloadPlugin(&api.ReloadPluginInput{
+			Mounts: []string{path},
+		}); err != nil {
+			return fmt.Errorf("error reloading plugin for mount %q: %s", path, err)
+		}
+	}
+
 	return nil
 }
 
@@ -235,6 +299,10 @@ func updateMount(d *schema.ResourceData, meta interface{}, excludeType bool) err
 		config.AllowedManagedKeys = expandStringSlice(d.Get("allowed_managed_keys").(*schema.Set).List())
 	}
 
+	if err := tuneMountExtendedConfig(d, client, path); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Updating mount %s in Vault", path)
 
 	// TODO: remove this work-around once VAULT-5521 is fixed
@@ -272,7 +340,11 @@ func mountDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func mountRead(d *schema.ResourceData, meta interface{}) error {
-	return readMount(d, meta, false)
+	if err := readMount(d, meta, false); err != nil {
+		return err
+	}
+
+	return setDeletionProtection(d)
 }
 
 func readMount(d *schema.ResourceData, meta interface{}, excludeType bool) error {
@@ -332,6 +404,27 @@ func readMount(d *schema.ResourceData, meta interface{}, excludeType bool) error
 	d.Set("external_entropy_access", mount.ExternalEntropyAccess)
 	d.Set("allowed_managed_keys", mount.Config.AllowedManagedKeys)
 
+	if provider.IsAPISupported(meta, provider.VaultVersion112) {
+		d.Set(consts.FieldPluginVersion, mount.PluginVersion)
+	}
+
+	// the extended config is only available from the mount's own endpoint.
+	if provider.IsAPISupported(meta, provider.VaultVersion115) {
+		resp, err := client.Logical().Read("sys/mounts/" + strings.Trim(path, "/"))
+		if err != nil {
+			return fmt.Errorf("error reading mount %q from Vault: %s", path, err)
+		}
+
+		if resp != nil {
+			if config, ok := resp.Data["config"].(map[string]interface{}); ok {
+				d.Set(consts.FieldDelegatedAuthAccessors, config[consts.FieldDelegatedAuthAccessors])
+				if provider.IsAPISupported(meta, provider.VaultVersion116) {
+					d.Set(consts.FieldIdentityTokenKey, config[consts.FieldIdentityTokenKey])
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
@@ -277,6 +279,242 @@ func TestResourceMountMangedKeys(t *testing.T) {
 	})
 }
 
+func TestResourceMount_DelegatedAuthAccessors(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+	authPath := acctest.RandomWithPrefix("tf-test-userpass")
+
+	resourceName := "vault_mount.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.15") {
+				t.Skip("delegated_auth_accessors requires Vault 1.15 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_delegatedAuthAccessorsConfig(path, authPath, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "delegated_auth_accessors.#", "0"),
+				),
+			},
+			{
+				Config: testResourceMount_delegatedAuthAccessorsConfig(path, authPath, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "delegated_auth_accessors.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "delegated_auth_accessors.0",
+						"vault_auth_backend.test", "accessor"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMount_DeletionProtection(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+
+	resourceName := "vault_mount.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testResourceMount_checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_deletionProtectionConfig(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      `locals {}`,
+				ExpectError: regexp.MustCompile("deletion protection is enabled"),
+			},
+			{
+				Config: testResourceMount_deletionProtectionConfig(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceMount_deletionProtectionConfig(path string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                = "%s"
+  type                = "kv"
+  deletion_protection = %t
+}
+`, path, deletionProtection)
+}
+
+func testResourceMount_checkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mount" {
+			continue
+		}
+
+		if _, err := findMount(rs.Primary.ID); err == nil {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testResourceMount_delegatedAuthAccessorsConfig(path, authPath string, withAccessors bool) string {
+	ret := fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  path = "%s"
+  type = "userpass"
+}
+`, authPath)
+
+	if withAccessors {
+		ret += fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                     = "%s"
+  type                     = "kv"
+  delegated_auth_accessors = [vault_auth_backend.test.accessor]
+}
+`, path)
+	} else {
+		ret += fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+`, path)
+	}
+
+	return ret
+}
+
+func TestResourceMount_PluginVersion(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+
+	resourceName := "vault_mount.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("plugin_version requires Vault 1.12 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				// the version is part of the mount input, an unknown version
+				// must fail the mount itself rather than a later tune.
+				Config:      testResourceMount_pluginVersionConfig(path, "v99.0.0"),
+				ExpectError: regexp.MustCompile("error writing to Vault"),
+			},
+			{
+				Config: testResourceMount_pluginVersionConfig(path, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPluginVersion, ""),
+				),
+			},
+		},
+	})
+}
+
+func testResourceMount_pluginVersionConfig(path, pluginVersion string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path           = "%s"
+  type           = "kv"
+  plugin_version = "%s"
+}
+`, path, pluginVersion)
+}
+
+func TestResourceMount_IdentityTokenKey(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+	keyName := acctest.RandomWithPrefix("tf-test-key")
+
+	resourceName := "vault_mount.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("identity_token_key requires Vault 1.16 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_identityTokenKeyConfig(path, keyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenKey, ""),
+				),
+			},
+			{
+				Config: testResourceMount_identityTokenKeyConfig(path, keyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenKey, keyName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceMount_identityTokenKeyConfig(path, keyName string, withKey bool) string {
+	ret := fmt.Sprintf(`
+resource "vault_identity_oidc_key" "test" {
+  name               = "%s"
+  allowed_client_ids = ["*"]
+}
+`, keyName)
+
+	if withKey {
+		ret += fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path               = "%s"
+  type               = "kv"
+  identity_token_key = vault_identity_oidc_key.test.name
+}
+`, path)
+	} else {
+		ret += fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+`, path)
+	}
+
+	return ret
+}
+
 func testResourceMount_managedKeysConfig(name, path string, isUpdate bool) string {
 	ret := fmt.Sprintf(`
 resource "vault_managed_keys" "keys" {
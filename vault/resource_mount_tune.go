@@ -0,0 +1,282 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var (
+	mountTuneTTLFields = map[string]string{
+		consts.FieldDefaultLeaseTTL: "default_lease_ttl",
+		consts.FieldMaxLeaseTTL:     "max_lease_ttl",
+	}
+
+	mountTuneListFields = []string{
+		consts.FieldAuditNonHMACRequestKeys,
+		consts.FieldAuditNonHMACResponseKeys,
+		consts.FieldPassthroughRequestHeaders,
+		consts.FieldAllowedResponseHeaders,
+	}
+
+	mountTuneStringFields = []string{
+		consts.FieldDescription,
+		consts.FieldListingVisibility,
+	}
+
+	// mountTuneUserLockoutFields maps the user_lockout_config request
+	// fields to the fields Vault returns when reading the tune endpoint.
+	mountTuneUserLockoutFields = map[string]string{
+		consts.FieldLockoutThreshold:            "user_lockout_threshold",
+		consts.FieldLockoutDuration:             "user_lockout_duration",
+		consts.FieldLockoutCounterResetDuration: "user_lockout_counter_reset_duration",
+		consts.FieldLockoutDisable:              "user_lockout_disable",
+	}
+)
+
+func mountTuneResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: mountTuneWrite,
+		ReadContext:   ReadContextWrapper(mountTuneRead),
+		UpdateContext: mountTuneWrite,
+		DeleteContext: mountTuneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldPath: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Path of the existing mount to tune. " +
+					"Auth mounts must be prefixed with 'auth/'.",
+				ValidateFunc: provider.ValidateNoLeadingTrailingSlashes,
+			},
+			consts.FieldDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Human-friendly description of the mount.",
+			},
+			consts.FieldDefaultLeaseTTL: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for tokens and secrets in seconds.",
+			},
+			consts.FieldMaxLeaseTTL: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for tokens and secrets in seconds.",
+			},
+			consts.FieldAuditNonHMACRequestKeys: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the list of keys that will not be HMAC'd by audit devices in the request data object.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldAuditNonHMACResponseKeys: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the list of keys that will not be HMAC'd by audit devices in the response data object.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldPassthroughRequestHeaders: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of headers to allow and pass from the request to the backend.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldAllowedResponseHeaders: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of headers to allow a plugin to include in the response.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldListingVisibility: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies whether to show this mount in the UI-specific listing endpoint.",
+				ValidateFunc: validation.StringInSlice([]string{"unauth", "hidden"}, false),
+			},
+			consts.FieldUserLockoutConfig: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "User lockout settings, only applicable to auth mounts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldLockoutThreshold: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The number of failed login attempts after which the user is locked out.",
+						},
+						consts.FieldLockoutDuration: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The duration in seconds for which the user is locked out.",
+						},
+						consts.FieldLockoutCounterResetDuration: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The duration in seconds after which the failed login counter is reset.",
+						},
+						consts.FieldLockoutDisable: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Disable the user lockout feature for the mount.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mountTuneWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldPath).(string)
+
+	data := map[string]interface{}{}
+	for k, apiKey := range mountTuneTTLFields {
+		if d.HasChange(k) {
+			data[apiKey] = fmt.Sprintf("%ds", d.Get(k))
+		}
+	}
+
+	for _, k := range mountTuneListFields {
+		if d.HasChange(k) {
+			data[k] = expandStringSliceWithEmpty(d.Get(k).([]interface{}), true)
+		}
+	}
+
+	for _, k := range mountTuneStringFields {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
+	if d.HasChange(consts.FieldUserLockoutConfig) {
+		lockout := map[string]interface{}{}
+		if v, ok := d.GetOk(consts.FieldUserLockoutConfig); ok && v.([]interface{})[0] != nil {
+			// zero is a valid value, so fields are sent whenever they are set or changed.
+			prefix := consts.FieldUserLockoutConfig + ".0."
+			if v, ok := d.GetOk(prefix + consts.FieldLockoutThreshold); ok || d.HasChange(prefix+consts.FieldLockoutThreshold) {
+				lockout[consts.FieldLockoutThreshold] = fmt.Sprintf("%d", v)
+			}
+			for _, k := range []string{consts.FieldLockoutDuration, consts.FieldLockoutCounterResetDuration} {
+				if v, ok := d.GetOk(prefix + k); ok || d.HasChange(prefix+k) {
+					lockout[k] = fmt.Sprintf("%ds", v)
+				}
+			}
+			lockout[consts.FieldLockoutDisable] = d.Get(prefix + consts.FieldLockoutDisable)
+		}
+		data[consts.FieldUserLockoutConfig] = lockout
+	}
+
+	if len(data) > 0 {
+		log.Printf("[DEBUG] Tuning mount %q", path)
+		if _, err := client.Logical().Write(mountTunePath(path), data); err != nil {
+			return diag.Errorf("error tuning mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tuned mount %q", path)
+	}
+
+	d.SetId(path)
+
+	return mountTuneRead(ctx, d, meta)
+}
+
+func mountTuneRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading tune configuration for mount %q", path)
+	resp, err := client.Logical().Read(mountTunePath(path))
+	if err != nil {
+		if util.ErrorContainsHTTPCode(err, 400, 404) {
+			log.Printf("[WARN] Mount %q not found, removing from state", path)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading tune configuration for mount %q: %s", path, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Mount %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldPath, path); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, apiKey := range mountTuneTTLFields {
+		if err := d.Set(k, resp.Data[apiKey]); err != nil {
+			return diag.Errorf("error setting state key %q on mount tune, err=%s", k, err)
+		}
+	}
+
+	for _, k := range append(mountTuneListFields, mountTuneStringFields...) {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on mount tune, err=%s", k, err)
+		}
+	}
+
+	// only track the lockout settings when they are managed by this resource,
+	// Vault reports the inherited defaults for every auth mount otherwise.
+	if _, ok := d.GetOk(consts.FieldUserLockoutConfig); ok {
+		lockout := map[string]interface{}{}
+		for k, apiKey := range mountTuneUserLockoutFields {
+			if v, ok := resp.Data[apiKey]; ok {
+				lockout[k] = v
+			}
+		}
+		if err := d.Set(consts.FieldUserLockoutConfig, []interface{}{lockout}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func mountTuneDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// the mount's lifecycle is not owned by this resource, so its tune
+	// settings are left as they are.
+	log.Printf("[DEBUG] Removing tune configuration for mount %q from state, "+
+		"settings in Vault are left unchanged", d.Id())
+
+	return nil
+}
+
+func mountTunePath(path string) string {
+	return "sys/mounts/" + path + "/tune"
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccMountTune(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+	resourceName := "vault_mount_tune.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountTuneConfig(path, 3600, `["foo"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultLeaseTTL, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxLeaseTTL, "7200"),
+					resource.TestCheckResourceAttr(resourceName, "audit_non_hmac_request_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audit_non_hmac_request_keys.0", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_request_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_request_headers.0", "X-Custom"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDescription, "externally managed"),
+				),
+			},
+			{
+				Config: testAccMountTuneConfig(path, 1800, `["foo", "bar"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultLeaseTTL, "1800"),
+					resource.TestCheckResourceAttr(resourceName, "audit_non_hmac_request_keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "audit_non_hmac_request_keys.1", "bar"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+			{
+				// the mount must outlive the tune resource
+				Config: testAccMountTuneConfigMountOnly(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", consts.FieldPath, path),
+					resource.TestCheckResourceAttr("vault_mount.test", consts.FieldDefaultLeaseTTL, "1800"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMountTuneConfigMountOnly(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`, path)
+}
+
+func testAccMountTuneConfig(path string, defaultTTL int, requestKeys string) string {
+	return testAccMountTuneConfigMountOnly(path) + fmt.Sprintf(`
+resource "vault_mount_tune" "test" {
+  path                        = vault_mount.test.path
+  description                 = "externally managed"
+  default_lease_ttl_seconds   = %d
+  max_lease_ttl_seconds       = 7200
+  audit_non_hmac_request_keys = %s
+  passthrough_request_headers = ["X-Custom"]
+}
+`, defaultTTL, requestKeys)
+}
@@ -1,9 +1,12 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,14 +23,22 @@ const (
 	SysNamespaceRoot = "sys/namespaces/"
 )
 
+// namespaceDefaultMounts are the mounts that Vault creates for every namespace.
+var namespaceDefaultMounts = map[string]bool{
+	"cubbyhole/":  true,
+	"identity/":   true,
+	"sys/":        true,
+	"auth/token/": true,
+}
+
 func namespaceResource() *schema.Resource {
-	return &schema.Resource{
+	return provider.MustAddDeletionProtectionSchema(&schema.Resource{
 		Create: namespaceCreate,
-		Update: namespaceCreate,
-		Delete: namespaceDelete,
+		Update: namespaceUpdate,
+		Delete: DeletionProtectionWrapper(namespaceDelete),
 		Read:   ReadWrapper(namespaceRead),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: namespaceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,8 +58,23 @@ func namespaceResource() *schema.Resource {
 				Computed:    true,
 				Description: "The fully qualified namespace path.",
 			},
+			consts.FieldCustomMetadata: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata describing the namespace. Requires Vault 1.12+.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldForceDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set, the namespace is deleted even if it still contains " +
+					"secrets engines or auth methods.",
+			},
 		},
-	}
+	})
 }
 
 func namespaceCreate(d *schema.ResourceData, meta interface{}) error {
@@ -59,8 +85,20 @@ func namespaceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Get(consts.FieldPath).(string)
 
+	var data map[string]interface{}
+	if v, ok := d.GetOk(consts.FieldCustomMetadata); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion112) {
+			return fmt.Errorf("%s requires Vault version %s or later, current version=%s",
+				consts.FieldCustomMetadata, provider.VaultVersion112,
+				meta.(*provider.ProviderMeta).GetVaultVersion())
+		}
+		data = map[string]interface{}{
+			consts.FieldCustomMetadata: v,
+		}
+	}
+
 	log.Printf("[DEBUG] Creating namespace %s in Vault", path)
-	_, err := client.Logical().Write(SysNamespaceRoot+path, nil)
+	_, err := client.Logical().Write(SysNamespaceRoot+path, data)
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
@@ -68,6 +106,42 @@ func namespaceCreate(d *schema.ResourceData, meta interface{}) error {
 	return namespaceRead(d, meta)
 }
 
+func namespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(consts.FieldPath) {
+		return namespaceCreate(d, meta)
+	}
+
+	if d.HasChange(consts.FieldCustomMetadata) {
+		client, e := provider.GetClient(d, meta)
+		if e != nil {
+			return e
+		}
+
+		path := d.Get(consts.FieldPath).(string)
+
+		// keys removed from the configuration must be explicitly
+		// set to null in the merge patch.
+		o, n := d.GetChange(consts.FieldCustomMetadata)
+		customMetadata := map[string]interface{}{}
+		for k := range o.(map[string]interface{}) {
+			customMetadata[k] = nil
+		}
+		for k, v := range n.(map[string]interface{}) {
+			customMetadata[k] = v
+		}
+
+		log.Printf("[DEBUG] Updating custom metadata of namespace %s in Vault", path)
+		if _, err := client.Logical().JSONMergePatch(context.Background(), SysNamespaceRoot+path,
+			map[string]interface{}{
+				consts.FieldCustomMetadata: customMetadata,
+			}); err != nil {
+			return fmt.Errorf("error updating custom metadata of namespace %q: %s", path, err)
+		}
+	}
+
+	return namespaceRead(d, meta)
+}
+
 func namespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -76,6 +150,12 @@ func namespaceDelete(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Get(consts.FieldPath).(string)
 
+	if !d.Get(consts.FieldForceDestroy).(bool) {
+		if err := namespaceCheckEmpty(d, meta); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting namespace %s from Vault", path)
 
 	deleteNS := func() error {
@@ -150,6 +230,9 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 		pathFQ = strings.Join([]string{parent.(string), path}, "/")
 	}
 	toSet[consts.FieldPathFQ] = pathFQ
+	toSet[consts.FieldDeletionProtection] = d.Get(consts.FieldDeletionProtection)
+	toSet[consts.FieldForceDestroy] = d.Get(consts.FieldForceDestroy)
+	toSet[consts.FieldCustomMetadata] = resp.Data[consts.FieldCustomMetadata]
 
 	if err := util.SetResourceData(d, toSet); err != nil {
 		return err
@@ -158,6 +241,71 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// namespaceCheckEmpty returns an error if the namespace still contains
+// secrets engines or auth methods, besides the ones Vault creates for every
+// namespace.
+func namespaceCheckEmpty(d *schema.ResourceData, meta interface{}) error {
+	pathFQ := d.Get(consts.FieldPathFQ).(string)
+	client, err := meta.(*provider.ProviderMeta).GetNSClient(pathFQ)
+	if err != nil {
+		return err
+	}
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error listing secrets engines of namespace %q: %s", pathFQ, err)
+	}
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error listing auth methods of namespace %q: %s", pathFQ, err)
+	}
+
+	var paths []string
+	for path := range mounts {
+		if !namespaceDefaultMounts[path] {
+			paths = append(paths, path)
+		}
+	}
+	for path := range auths {
+		if !namespaceDefaultMounts["auth/"+path] {
+			paths = append(paths, "auth/"+path)
+		}
+	}
+
+	if len(paths) > 0 {
+		sort.Strings(paths)
+		return fmt.Errorf("namespace %q still contains mounts %v, "+
+			"set %s to true to delete it anyway", pathFQ, paths, consts.FieldForceDestroy)
+	}
+
+	return nil
+}
+
+// namespaceImport supports importing nested namespaces by their path,
+// e.g. "parent/child" is imported as the namespace "child" in the "parent"
+// namespace.
+func namespaceImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	id := util.TrimSlashes(d.Id())
+	idx := strings.LastIndex(id, "/")
+	if idx < 0 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	parent := id[:idx]
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		parent = util.TrimSlashes(ns) + "/" + parent
+	}
+
+	log.Printf("[DEBUG] Importing namespace %q in parent namespace %q", id[idx+1:], parent)
+	if err := d.Set(consts.FieldNamespace, parent); err != nil {
+		return nil, err
+	}
+	d.SetId(id[idx+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func upgradeNonPathdNamespaceID(d *schema.ResourceData) {
 	// Upgrade ID to path
 	id := d.Id()
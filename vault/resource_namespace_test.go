@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -104,6 +105,74 @@ func testNamespaceCheckAttrs() resource.TestCheckFunc {
 	}
 }
 
+func TestAccNamespace_customMetadataAndForceDestroy(t *testing.T) {
+	namespacePath := acctest.RandomWithPrefix("test-ns")
+	resourceName := "vault_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("custom_metadata requires Vault 1.12 or later")
+			}
+		},
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(namespacePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testNamespaceCustomMetadataConfig(namespacePath, `{
+    foo = "bar"
+    baz = "qux"
+  }`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.baz", "qux"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldForceDestroy, "false"),
+				),
+			},
+			{
+				Config: testNamespaceCustomMetadataConfig(namespacePath, `{
+    foo = "updated"
+  }`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.foo", "updated"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client, err := testProvider.Meta().(*provider.ProviderMeta).GetNSClient(namespacePath)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := client.Sys().Mount("kv", &api.MountInput{Type: "kv"}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      `locals {}`,
+				ExpectError: regexp.MustCompile(`still contains mounts \[kv/\]`),
+			},
+			{
+				Config: testNamespaceCustomMetadataConfig(namespacePath, `{
+    foo = "updated"
+  }`, true),
+				Check: resource.TestCheckResourceAttr(resourceName, consts.FieldForceDestroy, "true"),
+			},
+		},
+	})
+}
+
+func testNamespaceCustomMetadataConfig(path, customMetadata string, forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path            = %q
+  force_destroy   = %t
+  custom_metadata = %s
+}
+`, path, forceDestroy, customMetadata)
+}
+
 func testNamespaceDestroy(path string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
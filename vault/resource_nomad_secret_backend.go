@@ -312,28 +312,20 @@ func updateNomadAccessConfigResource(d *schema.ResourceData, meta interface{}) e
 	configPath := fmt.Sprintf("%s/config/access", backend)
 	log.Printf("[DEBUG] Updating %q", configPath)
 
-	if raw, ok := d.GetOk("address"); ok {
-		data["address"] = raw
-	}
-
-	if raw, ok := d.GetOk("ca_cert"); ok {
-		data["ca_cert"] = raw
-	}
-
-	if raw, ok := d.GetOk("client_cert"); ok {
-		data["client_cert"] = raw
-	}
-
-	if raw, ok := d.GetOk("client_key"); ok {
-		data["client_key"] = raw
-	}
-
-	if raw, ok := d.GetOk("max_token_name_length"); ok {
-		data["max_token_name_length"] = raw
-	}
-
-	if raw, ok := d.GetOk("token"); ok {
-		data["token"] = raw
+	// only send the changed fields, Vault keeps the existing values of the
+	// omitted ones, and this allows the TLS settings to be cleared.
+	accessFields := []string{
+		"address",
+		"ca_cert",
+		"client_cert",
+		"client_key",
+		"max_token_name_length",
+		"token",
+	}
+	for _, k := range accessFields {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
 	}
 
 	if _, err := client.Logical().Write(configPath, data); err != nil {
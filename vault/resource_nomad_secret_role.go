@@ -193,16 +193,15 @@ func updateNomadRoleResource(d *schema.ResourceData, meta interface{}) error {
 	data := map[string]interface{}{}
 	data["type"] = roleType
 
-	if raw, ok := d.GetOk("global"); ok {
-		data["global"] = raw
-	}
+	// always send global, otherwise it can never be unset once enabled
+	data["global"] = d.Get("global")
 	if raw, ok := d.GetOk("policies"); ok {
 		if roleType == "client" {
 			data["policies"] = raw
 		}
 	}
 
-	if roleType == "client" && data["policies"] == "" {
+	if roleType == "client" && data["policies"] == nil {
 		return fmt.Errorf("error updating role %s: policies are required when role type is 'client'", roleName)
 	}
 
@@ -0,0 +1,150 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func pkiSecretBackendACMEEABResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendACMEEABCreate,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendACMEEABRead),
+		DeleteContext: pkiSecretBackendACMEEABDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldIssuer: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Restrict the token to the ACME directory of this issuer.",
+				ForceNew:    true,
+			},
+			consts.FieldRole: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Restrict the token to the ACME directory of this role.",
+				ForceNew:    true,
+			},
+			"eab_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The key identifier of the token.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64url encoded HMAC key of the token.",
+			},
+			consts.FieldKeyType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the HMAC key.",
+			},
+			"acme_directory": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ACME directory the token is bound to.",
+			},
+			"created_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the token.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendACMEEABCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion114) {
+		return diag.Errorf("PKI ACME requires Vault %s or later", provider.VaultVersion114)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+
+	path := backend
+	if v, ok := d.GetOk(consts.FieldIssuer); ok {
+		path += "/issuer/" + v.(string)
+	}
+	if v, ok := d.GetOk(consts.FieldRole); ok {
+		path += "/roles/" + v.(string)
+	}
+	path += "/acme/new-eab"
+
+	log.Printf("[DEBUG] Creating ACME EAB token on %q", path)
+	resp, err := client.Logical().Write(path, nil)
+	if err != nil {
+		return diag.Errorf("error creating ACME EAB token on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created ACME EAB token on %q", path)
+
+	var eabID string
+	if resp != nil {
+		eabID, _ = resp.Data["id"].(string)
+	}
+	if eabID == "" {
+		return diag.Errorf("no token ID returned creating ACME EAB token on %q", path)
+	}
+
+	// the key is only ever returned on creation
+	fields := map[string]string{
+		"eab_id":            "id",
+		"key":               "key",
+		consts.FieldKeyType: consts.FieldKeyType,
+		"acme_directory":    "acme_directory",
+		"created_on":        "created_on",
+	}
+	for k, f := range fields {
+		if err := d.Set(k, resp.Data[f]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(backend + "/eab/" + eabID)
+
+	return pkiSecretBackendACMEEABRead(ctx, d, meta)
+}
+
+// pkiSecretBackendACMEEABRead is a no-op, tokens are consumed once an ACME
+// account is bound to them and are then no longer listed by Vault.
+func pkiSecretBackendACMEEABRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func pkiSecretBackendACMEEABDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting ACME EAB token %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return diag.Errorf("error deleting ACME EAB token %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted ACME EAB token %q", path)
+
+	return nil
+}
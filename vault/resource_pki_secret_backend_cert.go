@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -41,6 +42,12 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Description: "CN of the certificate to create.",
 				ForceNew:    true,
 			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the issuer to sign the certificate with, defaults to the role's issuer.",
+				ForceNew:    true,
+			},
 			"alt_names": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -105,6 +112,13 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Description: "Flag to exclude CN from SANs.",
 				ForceNew:    true,
 			},
+			"cert_metadata": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Base64 encoded metadata stored with the certificate, " +
+					"unless the role disables it with no_store_metadata.",
+				ForceNew: true,
+			},
 			"auto_renew": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -148,6 +162,11 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Computed:    true,
 				Description: "The serial number.",
 			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer which signed the certificate.",
+			},
 			"expiration": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -179,6 +198,12 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 
 	path := pkiSecretBackendCertPath(backend, name)
+	if issuerRef, ok := d.GetOk("issuer_ref"); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+			return fmt.Errorf("issuer_ref requires Vault %s or later", provider.VaultVersion111)
+		}
+		path = pkiSecretBackendIssuerCertPath(backend, issuerRef.(string), name)
+	}
 
 	commonName := d.Get("common_name").(string)
 
@@ -231,6 +256,13 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 		data["other_sans"] = strings.Join(otherSans, ",")
 	}
 
+	if v, ok := d.GetOk("cert_metadata"); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion117) {
+			return fmt.Errorf("cert_metadata requires Vault %s or later", provider.VaultVersion117)
+		}
+		data["cert_metadata"] = v
+	}
+
 	log.Printf("[DEBUG] Creating certificate %s by %s on PKI secret backend %q", commonName, name, backend)
 	resp, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -251,6 +283,14 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 	d.Set("serial_number", resp.Data["serial_number"])
 	d.Set("expiration", resp.Data["expiration"])
 
+	if provider.IsAPISupported(meta, provider.VaultVersion111) {
+		issuerID, err := pkiSecretBackendCertIssuerID(client, backend, resp.Data)
+		if err != nil {
+			return err
+		}
+		d.Set("issuer_id", issuerID)
+	}
+
 	if err := pkiSecretBackendCertSynchronizeRenewPending(d); err != nil {
 		return err
 	}
@@ -358,6 +398,32 @@ func pkiSecretBackendCertPath(backend string, name string) string {
 	return strings.Trim(backend, "/") + "/issue/" + strings.Trim(name, "/")
 }
 
+func pkiSecretBackendIssuerCertPath(backend, issuerRef, name string) string {
+	return pkiSecretBackendIssuerPath(backend, issuerRef) + "/issue/" + strings.Trim(name, "/")
+}
+
+// pkiSecretBackendCertIssuerID returns the ID of the issuer of a newly issued
+// certificate, older Vault versions only return it when reading the
+// certificate back.
+func pkiSecretBackendCertIssuerID(client *api.Client, backend string, data map[string]interface{}) (string, error) {
+	if v, ok := data["issuer_id"].(string); ok && v != "" {
+		return v, nil
+	}
+
+	serialNumber, _ := data["serial_number"].(string)
+	path := strings.Trim(backend, "/") + "/cert/" + serialNumber
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading certificate %q: %w", path, err)
+	}
+	if resp == nil {
+		return "", nil
+	}
+
+	v, _ := resp.Data["issuer_id"].(string)
+	return v, nil
+}
+
 // pkiSecretBackendCertSynchronizeRenewPending calculates whether the
 // expiration time of the certificate is fewer than min_seconds_remaining
 // seconds in the future (relative to the current system time), and then
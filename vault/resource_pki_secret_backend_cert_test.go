@@ -292,6 +292,72 @@ resource "vault_pki_secret_backend_cert" "test" {
 `, rootPath)
 }
 
+func TestPkiSecretBackendCert_issuerRef(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("issuer_ref requires Vault 1.11 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCertConfig_issuerRef(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer_ref", "root-issuer"),
+					resource.TestCheckResourceAttrPair(resourceName, "issuer_id",
+						"vault_pki_secret_backend_issuer.test", "issuer_id"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendCertConfig_issuerRef(rootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test-root.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+
+  backend     = vault_mount.test-root.path
+  issuer_ref  = "default"
+  issuer_name = "root-issuer"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_mount.test-root.path
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  max_ttl          = "3600"
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  issuer_ref  = vault_pki_secret_backend_issuer.test.issuer_name
+  common_name = "cert.test.my.domain"
+  ttl         = "1h"
+}
+`, rootPath)
+}
+
 func testCapturePKICert(resourceName string, store *testPKICertStore) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
@@ -0,0 +1,187 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const pkiConfigACMESuffix = "/config/acme"
+
+func pkiSecretBackendConfigACMEResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendConfigACMEWrite,
+		UpdateContext: pkiSecretBackendConfigACMEWrite,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendConfigACMERead),
+		DeleteContext: pkiSecretBackendConfigACMEDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldEnabled: {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether ACME is enabled on the mount.",
+			},
+			"allowed_issuers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Issuers allowed to be used with ACME, * allows all issuers.",
+			},
+			"allowed_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Roles allowed to be used with ACME, * allows all roles.",
+			},
+			"allow_role_ext_key_usage": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the ExtKeyUsage field of roles is honored by ACME.",
+			},
+			"default_directory_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Policy of the default ACME directory, one of forbid, sign-verbatim " +
+					"or role:<role_name>.",
+			},
+			"dns_resolver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS resolver used for ACME challenges, in the form host:port.",
+			},
+			"eab_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"not-required", "new-account-required", "always-required",
+				}, false),
+				Description: "External account binding policy, one of not-required, " +
+					"new-account-required or always-required.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigACMEWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion114) {
+		return diag.Errorf("PKI ACME requires Vault %s or later", provider.VaultVersion114)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + pkiConfigACMESuffix
+
+	data := map[string]interface{}{
+		consts.FieldEnabled:        d.Get(consts.FieldEnabled),
+		"allow_role_ext_key_usage": d.Get("allow_role_ext_key_usage"),
+		"dns_resolver":             d.Get("dns_resolver"),
+	}
+	for _, k := range []string{"allowed_issuers", "allowed_roles"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = util.ToStringArray(v.([]interface{}))
+		}
+	}
+	for _, k := range []string{"default_directory_policy", "eab_policy"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing PKI ACME config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing PKI ACME config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI ACME config %q", path)
+
+	d.SetId(path)
+
+	return pkiSecretBackendConfigACMERead(ctx, d, meta)
+}
+
+func pkiSecretBackendConfigACMERead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading PKI ACME config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI ACME config %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI ACME config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, strings.TrimSuffix(path, pkiConfigACMESuffix)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range []string{
+		consts.FieldEnabled,
+		"allowed_issuers",
+		"allowed_roles",
+		"allow_role_ext_key_usage",
+		"default_directory_policy",
+		"dns_resolver",
+		"eab_policy",
+	} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigACMEDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling PKI ACME %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		consts.FieldEnabled: false,
+	}); err != nil {
+		return diag.Errorf("error disabling PKI ACME %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled PKI ACME %q", path)
+
+	return nil
+}
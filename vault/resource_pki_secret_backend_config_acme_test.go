@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendConfigACME_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_config_acme.test"
+	eabResourceName := "vault_pki_secret_backend_acme_eab.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.14") {
+				t.Skip("PKI ACME requires Vault 1.14 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigACMEConfig(backend, true, "new-account-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "default_directory_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "new-account-required"),
+					resource.TestCheckResourceAttrSet(eabResourceName, "eab_id"),
+					resource.TestCheckResourceAttrSet(eabResourceName, "key"),
+					resource.TestCheckResourceAttrSet(eabResourceName, "acme_directory"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigACMEConfig(backend, false, "not-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "false"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "not-required"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigACMEConfig(backend string, enabled bool, eabPolicy string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend = vault_mount.test.path
+  path    = "http://127.0.0.1:8200/v1/${vault_mount.test.path}"
+}
+
+resource "vault_pki_secret_backend_config_acme" "test" {
+  backend                  = vault_pki_secret_backend_config_cluster.test.backend
+  enabled                  = %t
+  allowed_issuers          = ["*"]
+  allowed_roles            = ["*"]
+  default_directory_policy = "sign-verbatim"
+  eab_policy               = "%s"
+}
+
+resource "vault_pki_secret_backend_acme_eab" "test" {
+  backend = vault_pki_secret_backend_config_acme.test.backend
+}
+`, backend, enabled, eabPolicy)
+}
@@ -0,0 +1,217 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const pkiConfigAutoTidySuffix = "/config/auto-tidy"
+
+// pkiSecretBackendTidyFields are the tidy operations and their settings,
+// shared by the auto-tidy config and the manual tidy trigger.
+var pkiSecretBackendTidyFields = []string{
+	"tidy_cert_store",
+	"tidy_revoked_certs",
+	"tidy_revoked_cert_issuer_associations",
+	"tidy_expired_issuers",
+	"tidy_move_legacy_ca_bundle",
+	"safety_buffer",
+	"issuer_safety_buffer",
+	"pause_duration",
+}
+
+func pkiSecretBackendTidySchema(forceNew bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		consts.FieldBackend: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The PKI secret backend the resource belongs to.",
+			ForceNew:    true,
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"tidy_cert_store": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to tidy up the certificate store.",
+		},
+		"tidy_revoked_certs": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to remove all invalid and expired certificates from storage.",
+		},
+		"tidy_revoked_cert_issuer_associations": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to associate revoked certificates with their issuers.",
+		},
+		"tidy_expired_issuers": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to remove expired issuers, after issuer_safety_buffer.",
+		},
+		"tidy_move_legacy_ca_bundle": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Whether to move the legacy CA bundle out of the way, " +
+				"requires Vault 1.13 or later.",
+		},
+		"safety_buffer": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			Description: "Number of seconds past a certificate's expiration " +
+				"before it is removed by tidy.",
+		},
+		"issuer_safety_buffer": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			Description: "Number of seconds past an issuer's expiration " +
+				"before it is removed by tidy.",
+		},
+		"pause_duration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Duration to pause between tidying certificates, e.g. 10ms.",
+		},
+	}
+
+	if forceNew {
+		for k, v := range s {
+			if k != consts.FieldBackend {
+				v.ForceNew = true
+			}
+		}
+	}
+
+	return s
+}
+
+// pkiSecretBackendTidyRequestData returns the configured tidy fields, the
+// unset ones are only sent when they are being cleared.
+func pkiSecretBackendTidyRequestData(d *schema.ResourceData, fields []string) map[string]interface{} {
+	data := make(map[string]interface{})
+	for _, k := range fields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	return data
+}
+
+func pkiSecretBackendConfigAutoTidyResource() *schema.Resource {
+	s := pkiSecretBackendTidySchema(false)
+	s["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Whether automatic tidy is enabled.",
+	}
+	s["interval_duration"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "Number of seconds between automatic tidy operations.",
+	}
+
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendConfigAutoTidyWrite,
+		UpdateContext: pkiSecretBackendConfigAutoTidyWrite,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendConfigAutoTidyRead),
+		DeleteContext: pkiSecretBackendConfigAutoTidyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+	}
+}
+
+func pkiSecretBackendConfigAutoTidyWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion112) {
+		return diag.Errorf("PKI auto-tidy requires Vault %s or later", provider.VaultVersion112)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + pkiConfigAutoTidySuffix
+
+	data := pkiSecretBackendTidyRequestData(d, append(pkiSecretBackendTidyFields, "interval_duration"))
+	data["enabled"] = d.Get("enabled")
+
+	log.Printf("[DEBUG] Writing PKI auto-tidy config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing PKI auto-tidy config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI auto-tidy config %q", path)
+
+	d.SetId(path)
+
+	return pkiSecretBackendConfigAutoTidyRead(ctx, d, meta)
+}
+
+func pkiSecretBackendConfigAutoTidyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading PKI auto-tidy config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI auto-tidy config %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI auto-tidy config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, strings.TrimSuffix(path, pkiConfigAutoTidySuffix)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range append(pkiSecretBackendTidyFields, "enabled", "interval_duration") {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling PKI auto-tidy %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	}); err != nil {
+		return diag.Errorf("error disabling PKI auto-tidy %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled PKI auto-tidy %q", path)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendConfigAutoTidy_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_config_auto_tidy.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("PKI auto-tidy requires Vault 1.12 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interval_duration", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_cert_store", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_revoked_certs", "true"),
+					resource.TestCheckResourceAttr(resourceName, "safety_buffer", "86400"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_revoked_certs", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigAutoTidyConfig(backend string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_config_auto_tidy" "test" {
+  backend            = vault_mount.test.path
+  enabled            = %t
+  interval_duration  = 3600
+  tidy_cert_store    = true
+  tidy_revoked_certs = %t
+  safety_buffer      = 86400
+}
+`, backend, enabled, enabled)
+}
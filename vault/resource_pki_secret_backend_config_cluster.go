@@ -0,0 +1,131 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const pkiConfigClusterSuffix = "/config/cluster"
+
+func pkiSecretBackendConfigClusterResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendConfigClusterWrite,
+		UpdateContext: pkiSecretBackendConfigClusterWrite,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendConfigClusterRead),
+		DeleteContext: pkiSecretBackendConfigClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldPath: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Canonical URL to the mount on the local cluster, " +
+					"e.g. https://vault.example.com/v1/pki.",
+			},
+			"aia_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "URL to the mount's AIA distribution point, " +
+					"may refer to an external non-Vault responder.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigClusterWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion113) {
+		return diag.Errorf("PKI cluster config requires Vault %s or later", provider.VaultVersion113)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + pkiConfigClusterSuffix
+
+	data := map[string]interface{}{
+		consts.FieldPath: d.Get(consts.FieldPath),
+		"aia_path":       d.Get("aia_path"),
+	}
+
+	log.Printf("[DEBUG] Writing PKI cluster config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing PKI cluster config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI cluster config %q", path)
+
+	d.SetId(path)
+
+	return pkiSecretBackendConfigClusterRead(ctx, d, meta)
+}
+
+func pkiSecretBackendConfigClusterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading PKI cluster config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI cluster config %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI cluster config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, strings.TrimSuffix(path, pkiConfigClusterSuffix)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range []string{consts.FieldPath, "aia_path"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Clearing PKI cluster config %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		consts.FieldPath: "",
+		"aia_path":       "",
+	}); err != nil {
+		return diag.Errorf("error clearing PKI cluster config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Cleared PKI cluster config %q", path)
+
+	return nil
+}
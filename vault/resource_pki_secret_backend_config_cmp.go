@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const pkiConfigCMPSuffix = "/config/cmp"
+
+func pkiSecretBackendConfigCMPResource() *schema.Resource {
+	s := pkiSecretBackendEnrollmentSchema("cert")
+	s["disabled_validations"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "CMP validations to disable, e.g. DisableMatchingKeyIdValidation.",
+	}
+
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendConfigCMPWrite,
+		UpdateContext: pkiSecretBackendConfigCMPWrite,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendConfigCMPRead),
+		DeleteContext: pkiSecretBackendConfigCMPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+	}
+}
+
+func pkiSecretBackendConfigCMPWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion118) {
+		return diag.Errorf("PKI CMP requires Vault %s or later", provider.VaultVersion118)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + pkiConfigCMPSuffix
+
+	data := pkiSecretBackendEnrollmentRequestData(d)
+	data["disabled_validations"] = util.ToStringArray(d.Get("disabled_validations").([]interface{}))
+
+	log.Printf("[DEBUG] Writing PKI CMP config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing PKI CMP config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI CMP config %q", path)
+
+	d.SetId(path)
+
+	return pkiSecretBackendConfigCMPRead(ctx, d, meta)
+}
+
+func pkiSecretBackendConfigCMPRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading PKI CMP config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI CMP config %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI CMP config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, strings.TrimSuffix(path, pkiConfigCMPSuffix)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := pkiSecretBackendEnrollmentSetData(d, resp.Data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := resp.Data["disabled_validations"]; ok {
+		if err := d.Set("disabled_validations", v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigCMPDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling PKI CMP %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		consts.FieldEnabled: false,
+	}); err != nil {
+		return diag.Errorf("error disabling PKI CMP %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled PKI CMP %q", path)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendConfigCMP_basic(t *testing.T) {
+	testutil.SkipTestAccEnt(t)
+
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_config_cmp.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.18") {
+				t.Skip("PKI CMP requires Vault 1.18 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigCMPConfig(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_path_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr(resourceName, "disabled_validations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "disabled_validations.0", "DisableMatchingKeyIdValidation"),
+					resource.TestCheckResourceAttrPair(resourceName, "authenticators.0.cert.accessor",
+						"vault_auth_backend.test", "accessor"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigCMPConfig(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigCMPConfig(backend string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_auth_backend" "test" {
+  type = "cert"
+  path = "%s-cert"
+}
+
+resource "vault_pki_secret_backend_config_cmp" "test" {
+  backend              = vault_mount.test.path
+  enabled              = %t
+  default_path_policy  = "sign-verbatim"
+  disabled_validations = ["DisableMatchingKeyIdValidation"]
+
+  authenticators {
+    cert = {
+      accessor = vault_auth_backend.test.accessor
+    }
+  }
+}
+`, backend, backend, enabled)
+}
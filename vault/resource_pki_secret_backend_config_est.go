@@ -0,0 +1,244 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const pkiConfigESTSuffix = "/config/est"
+
+// pkiSecretBackendEnrollmentSchema returns the schema shared by the
+// enrollment protocol configs, authMethods are the auth method types which
+// may authenticate enrollment requests.
+func pkiSecretBackendEnrollmentSchema(authMethods ...string) map[string]*schema.Schema {
+	authenticators := map[string]*schema.Schema{}
+	for _, m := range authMethods {
+		authenticators[m] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "The " + m + " auth method authenticating the requests, " +
+				"e.g. {accessor = \"auth_" + m + "_1234\"}.",
+		}
+	}
+
+	return map[string]*schema.Schema{
+		consts.FieldBackend: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The PKI secret backend the resource belongs to.",
+			ForceNew:    true,
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		consts.FieldEnabled: {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Whether the protocol is enabled on the mount.",
+		},
+		"default_path_policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Policy of the default path, either sign-verbatim " +
+				"or role:<role_name>.",
+		},
+		"authenticators": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "Auth methods allowed to authenticate the requests.",
+			Elem: &schema.Resource{
+				Schema: authenticators,
+			},
+		},
+		"enable_sentinel_parsing": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to parse the CSRs for Sentinel policies.",
+		},
+		"audit_fields": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Fields parsed from the CSRs which are added to the audit logs.",
+		},
+		"last_updated": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time of the last update of the config.",
+		},
+	}
+}
+
+func pkiSecretBackendEnrollmentRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		consts.FieldEnabled:       d.Get(consts.FieldEnabled),
+		"default_path_policy":     d.Get("default_path_policy"),
+		"enable_sentinel_parsing": d.Get("enable_sentinel_parsing"),
+	}
+
+	if v, ok := d.GetOk("audit_fields"); ok {
+		data["audit_fields"] = util.ToStringArray(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("authenticators"); ok {
+		authenticators := map[string]interface{}{}
+		if raw, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			for k, m := range raw {
+				if m := m.(map[string]interface{}); len(m) > 0 {
+					authenticators[k] = m
+				}
+			}
+		}
+		data["authenticators"] = authenticators
+	}
+
+	return data
+}
+
+func pkiSecretBackendEnrollmentSetData(d *schema.ResourceData, resp map[string]interface{}) error {
+	for _, k := range []string{
+		consts.FieldEnabled,
+		"default_path_policy",
+		"enable_sentinel_parsing",
+		"audit_fields",
+		"last_updated",
+	} {
+		if v, ok := resp[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := resp["authenticators"].(map[string]interface{}); ok {
+		if err := d.Set("authenticators", []interface{}{v}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigESTResource() *schema.Resource {
+	s := pkiSecretBackendEnrollmentSchema("cert", "userpass")
+	s["default_mount"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the mount is served on the default EST path, /.well-known/est.",
+	}
+	s["label_to_path_policy"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Map of EST labels to path policies, either sign-verbatim or role:<role_name>.",
+	}
+
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendConfigESTWrite,
+		UpdateContext: pkiSecretBackendConfigESTWrite,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendConfigESTRead),
+		DeleteContext: pkiSecretBackendConfigESTDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+	}
+}
+
+func pkiSecretBackendConfigESTWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+		return diag.Errorf("PKI EST requires Vault %s or later", provider.VaultVersion116)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + pkiConfigESTSuffix
+
+	data := pkiSecretBackendEnrollmentRequestData(d)
+	data["default_mount"] = d.Get("default_mount")
+	data["label_to_path_policy"] = d.Get("label_to_path_policy")
+
+	log.Printf("[DEBUG] Writing PKI EST config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing PKI EST config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI EST config %q", path)
+
+	d.SetId(path)
+
+	return pkiSecretBackendConfigESTRead(ctx, d, meta)
+}
+
+func pkiSecretBackendConfigESTRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading PKI EST config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI EST config %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI EST config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, strings.TrimSuffix(path, pkiConfigESTSuffix)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := pkiSecretBackendEnrollmentSetData(d, resp.Data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range []string{"default_mount", "label_to_path_policy"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigESTDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling PKI EST %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		consts.FieldEnabled: false,
+	}); err != nil {
+		return diag.Errorf("error disabling PKI EST %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled PKI EST %q", path)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendConfigEST_basic(t *testing.T) {
+	testutil.SkipTestAccEnt(t)
+
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_config_est.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("PKI EST requires Vault 1.16 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigESTConfig(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_mount", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_path_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr(resourceName, "label_to_path_policy.test-label", "role:test"),
+					resource.TestCheckResourceAttrPair(resourceName, "authenticators.0.userpass.accessor",
+						"vault_auth_backend.test", "accessor"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigESTConfig(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigESTConfig(backend string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_auth_backend" "test" {
+  type = "userpass"
+  path = "%s-userpass"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend        = vault_mount.test.path
+  name           = "test"
+  allow_any_name = true
+}
+
+resource "vault_pki_secret_backend_config_est" "test" {
+  backend              = vault_pki_secret_backend_role.test.backend
+  enabled              = %t
+  default_mount        = true
+  default_path_policy  = "sign-verbatim"
+  label_to_path_policy = {
+    "test-label" = "role:test"
+  }
+
+  authenticators {
+    userpass = {
+      accessor = vault_auth_backend.test.accessor
+    }
+  }
+}
+`, backend, backend, enabled)
+}
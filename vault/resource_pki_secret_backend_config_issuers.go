@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const pkiConfigIssuersSuffix = "/config/issuers"
+
+func pkiSecretBackendConfigIssuersResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendConfigIssuersWrite,
+		UpdateContext: pkiSecretBackendConfigIssuersWrite,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendConfigIssuersRead),
+		DeleteContext: pkiSecretBackendConfigIssuersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldDefault: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference to the default issuer, either its ID or its name.",
+			},
+			consts.FieldDefaultFollowsLatestIssuer: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: "Whether the default issuer is updated to the latest issuer " +
+					"generated or imported into the mount, requires Vault 1.13 or later.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigIssuersWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+		return diag.Errorf("PKI issuers require Vault %s or later", provider.VaultVersion111)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + pkiConfigIssuersSuffix
+
+	data := map[string]interface{}{
+		consts.FieldDefault: d.Get(consts.FieldDefault),
+	}
+	// only send the flag when configured, older Vault versions reject it
+	if v, ok := d.GetOkExists(consts.FieldDefaultFollowsLatestIssuer); ok {
+		data[consts.FieldDefaultFollowsLatestIssuer] = v
+	}
+
+	log.Printf("[DEBUG] Writing PKI issuers config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing PKI issuers config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI issuers config %q", path)
+
+	d.SetId(path)
+
+	return pkiSecretBackendConfigIssuersRead(ctx, d, meta)
+}
+
+func pkiSecretBackendConfigIssuersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, pkiConfigIssuersSuffix)
+
+	log.Printf("[DEBUG] Reading PKI issuers config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI issuers config %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI issuers config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, backend); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Vault always returns the issuer ID, keep the configured reference as
+	// long as it still resolves to the same issuer.
+	def, _ := resp.Data[consts.FieldDefault].(string)
+	if ref := d.Get(consts.FieldDefault).(string); ref != "" && ref != def {
+		issuer, err := client.Logical().Read(pkiSecretBackendIssuerPath(backend, ref))
+		if err == nil && issuer != nil && issuer.Data[consts.FieldIssuerID] == def {
+			def = ref
+		}
+	}
+	if err := d.Set(consts.FieldDefault, def); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := resp.Data[consts.FieldDefaultFollowsLatestIssuer]; ok {
+		if err := d.Set(consts.FieldDefaultFollowsLatestIssuer, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// pkiSecretBackendConfigIssuersDelete only removes the config from the state,
+// a PKI mount with issuers always has a default issuer.
+func pkiSecretBackendConfigIssuersDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing PKI issuers config %q from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendConfigIssuers_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki-root")
+	resourceName := "vault_pki_secret_backend_config_issuers.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("PKI issuers require Vault 1.11 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigIssuersConfig(backend, "vault_pki_secret_backend_issuer.test.issuer_name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefault, "root-issuer"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigIssuersConfig(backend, "vault_pki_secret_backend_issuer.test.issuer_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, consts.FieldDefault,
+						"vault_pki_secret_backend_issuer.test", consts.FieldIssuerID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigIssuersConfig(backend, ref string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test.example.com"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+
+  backend     = vault_mount.test.path
+  issuer_ref  = "default"
+  issuer_name = "root-issuer"
+}
+
+resource "vault_pki_secret_backend_config_issuers" "test" {
+  backend = vault_mount.test.path
+  default = %s
+}
+`, backend, ref)
+}
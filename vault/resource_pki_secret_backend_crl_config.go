@@ -38,6 +38,57 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 				Optional:    true,
 				Description: "Disables or enables CRL building",
 			},
+			"ocsp_disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disables or enables the OCSP responder in Vault.",
+			},
+			"ocsp_expiry": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of time an OCSP response can be cached for.",
+			},
+			"auto_rebuild": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables or disables periodic rebuilding of the CRL upon expiry.",
+			},
+			"auto_rebuild_grace_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Grace period before CRL expiry to attempt rebuild of CRL.",
+			},
+			"enable_delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables or disables building of delta CRLs with up-to-date revocation information.",
+			},
+			"delta_rebuild_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval to check for new revocations on, to regenerate the delta CRL.",
+			},
+			"cross_cluster_revocation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Enable cross-cluster revocation request queues, " +
+					"requires Vault Enterprise 1.13 or later.",
+			},
+			"unified_crl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Enables unified CRL and OCSP building across clusters, " +
+					"requires Vault Enterprise 1.13 or later.",
+			},
+			"unified_crl_on_existing_paths": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Enables serving the unified CRL and OCSP on the existing, " +
+					"previously cluster-local paths, requires Vault Enterprise 1.13 or later.",
+			},
 		},
 	}
 }
@@ -51,13 +102,7 @@ func pkiSecretBackendCrlConfigCreate(d *schema.ResourceData, meta interface{}) e
 	backend := d.Get("backend").(string)
 	path := pkiSecretBackendCrlConfigPath(backend)
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d, meta)
 
 	log.Printf("[DEBUG] Creating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -92,8 +137,13 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
-	d.Set("expiry", config.Data["expiry"])
-	d.Set("disable", config.Data["disable"])
+	for _, k := range pkiSecretBackendCrlConfigFields(meta) {
+		if v, ok := config.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
 
 	return nil
 }
@@ -107,13 +157,7 @@ func pkiSecretBackendCrlConfigUpdate(d *schema.ResourceData, meta interface{}) e
 	path := d.Id()
 	backend := pkiSecretBackendCrlConfigPath(path)
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d, meta)
 
 	log.Printf("[DEBUG] Updating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -129,6 +173,44 @@ func pkiSecretBackendCrlConfigDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// pkiSecretBackendCrlConfigFields returns the fields supported by the Vault
+// server, newer fields are rejected by older versions.
+func pkiSecretBackendCrlConfigFields(meta interface{}) []string {
+	fields := []string{"expiry", "disable"}
+	if provider.IsAPISupported(meta, provider.VaultVersion112) {
+		fields = append(fields,
+			"ocsp_disable",
+			"ocsp_expiry",
+			"auto_rebuild",
+			"auto_rebuild_grace_period",
+			"enable_delta",
+			"delta_rebuild_interval",
+		)
+	}
+	if provider.IsAPISupported(meta, provider.VaultVersion113) {
+		fields = append(fields,
+			"cross_cluster_revocation",
+			"unified_crl",
+			"unified_crl_on_existing_paths",
+		)
+	}
+
+	return fields
+}
+
+func pkiSecretBackendCrlConfigRequestData(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	// Vault only updates the fields present in the request, unset fields are
+	// sent when they are being cleared.
+	data := make(map[string]interface{})
+	for _, k := range pkiSecretBackendCrlConfigFields(meta) {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	return data
+}
+
 func pkiSecretBackendCrlConfigPath(backend string) string {
 	return strings.Trim(backend, "/") + "/config/crl"
 }
@@ -31,6 +31,60 @@ func TestPkiSecretBackendCrlConfig_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendCrlConfig_delta(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_crl_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("delta CRLs require Vault 1.12 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_delta(rootPath, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild_grace_period", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "enable_delta", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delta_rebuild_interval", "30m"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_expiry", "6h"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_delta(rootPath, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_delta", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendCrlConfigConfig_delta(rootPath string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_crl_config" "test" {
+  backend                   = vault_mount.test-root.path
+  auto_rebuild              = %t
+  auto_rebuild_grace_period = "24h"
+  enable_delta              = %t
+  delta_rebuild_interval    = "30m"
+  ocsp_expiry               = "6h"
+}
+`, rootPath, enabled, enabled)
+}
+
 func testPkiSecretBackendCrlConfigConfig_basic(rootPath string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test-root" {
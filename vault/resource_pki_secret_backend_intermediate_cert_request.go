@@ -28,9 +28,20 @@ func pkiSecretBackendIntermediateCertRequestResource() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Type of intermediate to create. Must be either \"exported\" or \"internal\".",
+				Description:  "Type of intermediate to create. Must be either \"exported\", \"internal\", \"kms\" or \"existing\".",
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms", "existing"}, false),
+			},
+			"key_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the existing key to generate the CSR with, only used with type \"existing\".",
+				ForceNew:    true,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key the CSR was generated with.",
 			},
 			"common_name": {
 				Type:        schema.TypeString,
@@ -238,9 +249,8 @@ func pkiSecretBackendIntermediateCertRequestCreate(ctx context.Context, d *schem
 		"managed_key_id":       d.Get("managed_key_id").(string),
 	}
 
-	if intermediateType != "kms" {
-		data["key_type"] = d.Get("key_type").(string)
-		data["key_bits"] = d.Get("key_bits").(int)
+	if err := pkiSecretBackendKeySourceRequestData(d, meta, intermediateType, data); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(altNames) > 0 {
@@ -270,6 +280,10 @@ func pkiSecretBackendIntermediateCertRequestCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("key_id", resp.Data["key_id"]); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.Get("type") == "exported" {
 		if err := d.Set("private_key", resp.Data["private_key"]); err != nil {
 			return diag.FromErr(err)
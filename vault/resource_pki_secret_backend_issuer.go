@@ -0,0 +1,293 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+// pkiSecretBackendIssuerAIAFields are the per-issuer AIA URL fields.
+var pkiSecretBackendIssuerAIAFields = []string{
+	consts.FieldIssuingCertificates,
+	consts.FieldCRLDistributionPoints,
+	consts.FieldOCSPServers,
+}
+
+func pkiSecretBackendIssuerResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendIssuerCreateUpdate,
+		UpdateContext: pkiSecretBackendIssuerCreateUpdate,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendIssuerRead),
+		DeleteContext: pkiSecretBackendIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: pkiSecretBackendIssuerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the issuer belongs to.",
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldIssuerRef: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference to an existing issuer, either its ID or its name.",
+				ForceNew:    true,
+			},
+			consts.FieldIssuerName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the issuer, must be unique within the mount.",
+			},
+			consts.FieldUsage: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"read-only", "issuing-certificates", "crl-signing", "ocsp-signing",
+					}, false),
+				},
+				Description: "Allowed usages for the issuer, any of read-only, " +
+					"issuing-certificates, crl-signing and ocsp-signing.",
+			},
+			consts.FieldLeafNotAfterBehavior: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"err", "truncate", "permit"}, false),
+				Description: "Behavior of a leaf certificate's NotAfter field when it is " +
+					"beyond the issuer's expiration, one of err, truncate or permit.",
+			},
+			consts.FieldManualChain: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Ordered list of issuer references making up the CA chain of the issuer.",
+			},
+			consts.FieldRevocationSignatureAlgorithm: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Signature algorithm used to sign CRLs and OCSP responses, " +
+					"the default is chosen from the issuer's key type.",
+			},
+			consts.FieldIssuingCertificates: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies the URL values for the Issuing Certificate field.",
+			},
+			consts.FieldCRLDistributionPoints: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies the URL values for the CRL Distribution Points field.",
+			},
+			consts.FieldOCSPServers: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies the URL values for the OCSP Servers field.",
+			},
+			consts.FieldEnableAIAURLTemplating: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether the AIA URLs may contain templates, " +
+					"requires Vault 1.13 or later.",
+			},
+			consts.FieldIssuerID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the issuer.",
+			},
+			consts.FieldKeyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the key used by the issuer.",
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate of the issuer.",
+			},
+			consts.FieldCAChain: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The PEM encoded CA chain of the issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuerCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+		return diag.Errorf("PKI issuers require Vault %s or later", provider.VaultVersion111)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	ref := d.Get(consts.FieldIssuerRef).(string)
+	if d.Id() != "" {
+		// the issuer may have been renamed, always address it by its ID
+		ref = d.Get(consts.FieldIssuerID).(string)
+	}
+	path := pkiSecretBackendIssuerPath(backend, ref)
+
+	// Vault resets any field omitted from the request, so send them all.
+	data := map[string]interface{}{
+		consts.FieldManualChain: util.ToStringArray(d.Get(consts.FieldManualChain).([]interface{})),
+	}
+	for _, k := range pkiSecretBackendIssuerAIAFields {
+		data[k] = util.ToStringArray(d.Get(k).([]interface{}))
+	}
+	for _, k := range []string{
+		consts.FieldIssuerName,
+		consts.FieldLeafNotAfterBehavior,
+		consts.FieldRevocationSignatureAlgorithm,
+	} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	if v, ok := d.GetOk(consts.FieldUsage); ok {
+		data[consts.FieldUsage] = strings.Join(
+			util.ToStringArray(v.(*schema.Set).List()), ",")
+	}
+	// only send the flag when set, older Vault versions reject it
+	if v, ok := d.GetOk(consts.FieldEnableAIAURLTemplating); ok {
+		data[consts.FieldEnableAIAURLTemplating] = v
+	}
+
+	log.Printf("[DEBUG] Writing PKI issuer %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return diag.Errorf("error writing PKI issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote PKI issuer %q", path)
+
+	var issuerID string
+	if resp != nil {
+		issuerID, _ = resp.Data[consts.FieldIssuerID].(string)
+	}
+	if issuerID == "" {
+		return diag.Errorf("no issuer ID returned writing PKI issuer %q", path)
+	}
+	d.SetId(pkiSecretBackendIssuerPath(backend, issuerID))
+
+	return pkiSecretBackendIssuerRead(ctx, d, meta)
+}
+
+func pkiSecretBackendIssuerRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading PKI issuer %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI issuer %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI issuer %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{
+		consts.FieldIssuerID,
+		consts.FieldIssuerName,
+		consts.FieldLeafNotAfterBehavior,
+		consts.FieldRevocationSignatureAlgorithm,
+		consts.FieldCertificate,
+		consts.FieldCAChain,
+		consts.FieldManualChain,
+		consts.FieldKeyID,
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var usage []string
+	if v, ok := resp.Data[consts.FieldUsage].(string); ok && v != "" {
+		usage = strings.Split(v, ",")
+	}
+	if err := d.Set(consts.FieldUsage, usage); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range pkiSecretBackendIssuerAIAFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if v, ok := resp.Data[consts.FieldEnableAIAURLTemplating]; ok {
+		if err := d.Set(consts.FieldEnableAIAURLTemplating, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// pkiSecretBackendIssuerDelete only removes the issuer from the state, the
+// issuer itself is owned by the resource which generated or imported it.
+func pkiSecretBackendIssuerDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing PKI issuer %q from state", d.Id())
+
+	return nil
+}
+
+func pkiSecretBackendIssuerImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	backend, ref, err := pkiSecretBackendIssuerParsePath(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(consts.FieldBackend, backend); err != nil {
+		return nil, err
+	}
+	if err := d.Set(consts.FieldIssuerRef, ref); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func pkiSecretBackendIssuerPath(backend, ref string) string {
+	return strings.Trim(backend, "/") + "/issuer/" + ref
+}
+
+func pkiSecretBackendIssuerParsePath(path string) (string, string, error) {
+	i := strings.LastIndex(path, "/issuer/")
+	if i <= 0 || i+len("/issuer/") == len(path) {
+		return "", "", fmt.Errorf("invalid PKI issuer ID %q, expected <backend>/issuer/<issuer_ref>", path)
+	}
+
+	return path[:i], path[i+len("/issuer/"):], nil
+}
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func pkiSecretBackendIssuerImportResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendIssuerImportCreate,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendIssuerImportRead),
+		DeleteContext: pkiSecretBackendIssuerImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the issuers are imported into.",
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldPEMBundle: {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "PEM encoded certificates to import, e.g. a cross-signed " +
+					"certificate of an existing key.",
+				ForceNew: true,
+			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the issuers created by the import.",
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the keys created by the import.",
+			},
+			"mapping": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of the imported issuer IDs to the IDs of their keys.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuerImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+		return diag.Errorf("PKI issuers require Vault %s or later", provider.VaultVersion111)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + "/issuers/import/cert"
+	data := map[string]interface{}{
+		consts.FieldPEMBundle: d.Get(consts.FieldPEMBundle),
+	}
+
+	log.Printf("[DEBUG] Importing PKI issuers on %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return diag.Errorf("error importing PKI issuers on %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("empty response importing PKI issuers on %q", path)
+	}
+	log.Printf("[DEBUG] Imported PKI issuers on %q", path)
+
+	for _, k := range []string{"imported_issuers", "imported_keys", "mapping"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return pkiSecretBackendIssuerImportRead(ctx, d, meta)
+}
+
+// pkiSecretBackendIssuerImportRead is a no-op, the imported issuers are managed
+// through vault_pki_secret_backend_issuer.
+func pkiSecretBackendIssuerImportRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// pkiSecretBackendIssuerImportDelete deletes the issuers created by the
+// import, issuers which already existed in the backend are left untouched.
+func pkiSecretBackendIssuerImportDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	for _, issuerID := range util.ToStringArray(d.Get("imported_issuers").([]interface{})) {
+		path := pkiSecretBackendIssuerPath(backend, issuerID)
+
+		log.Printf("[DEBUG] Deleting PKI issuer %q", path)
+		if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+			return diag.Errorf("error deleting PKI issuer %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Deleted PKI issuer %q", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendIssuerImport_crossSign(t *testing.T) {
+	oldRoot := acctest.RandomWithPrefix("pki-old-root")
+	newRoot := acctest.RandomWithPrefix("pki-new-root")
+	resourceName := "vault_pki_secret_backend_issuer_import.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("PKI issuers require Vault 1.11 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerImportConfig_crossSign(oldRoot, newRoot),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "imported_issuers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "imported_keys.#", "0"),
+					resource.TestCheckResourceAttrPair(
+						"vault_pki_secret_backend_intermediate_cert_request.cross", "key_id",
+						"vault_pki_secret_backend_issuer.new", consts.FieldKeyID),
+					testPkiSecretBackendIssuerImportMapping(resourceName, "vault_pki_secret_backend_issuer.new"),
+				),
+			},
+		},
+	})
+}
+
+// testPkiSecretBackendIssuerImportMapping checks that the cross-signed issuer
+// was attached to the key of the existing issuer.
+func testPkiSecretBackendIssuerImportMapping(resourceName, issuerResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+		issuer, err := testutil.GetResourceFromRootModule(s, issuerResourceName)
+		if err != nil {
+			return err
+		}
+
+		issuerID := rs.Primary.Attributes["imported_issuers.0"]
+		keyID := issuer.Primary.Attributes[consts.FieldKeyID]
+		if v := rs.Primary.Attributes["mapping."+issuerID]; v != keyID {
+			return fmt.Errorf("expected issuer %q to use key %q, got %q", issuerID, keyID, v)
+		}
+
+		return nil
+	}
+}
+
+func testPkiSecretBackendIssuerImportConfig_crossSign(oldRoot, newRoot string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "old" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_mount" "new" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "old" {
+  backend     = vault_mount.old.path
+  type        = "internal"
+  common_name = "old-root.example.com"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "new" {
+  backend     = vault_mount.new.path
+  type        = "internal"
+  common_name = "new-root.example.com"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "new" {
+  depends_on = [vault_pki_secret_backend_root_cert.new]
+
+  backend    = vault_mount.new.path
+  issuer_ref = "default"
+}
+
+resource "vault_pki_secret_backend_intermediate_cert_request" "cross" {
+  backend     = vault_mount.new.path
+  type        = "existing"
+  key_ref     = vault_pki_secret_backend_issuer.new.key_id
+  common_name = "new-root.example.com"
+}
+
+resource "vault_pki_secret_backend_root_sign_intermediate" "cross" {
+  depends_on = [vault_pki_secret_backend_root_cert.old]
+
+  backend        = vault_mount.old.path
+  issuer_ref     = "default"
+  csr            = vault_pki_secret_backend_intermediate_cert_request.cross.csr
+  common_name    = "new-root.example.com"
+  use_csr_values = true
+}
+
+resource "vault_pki_secret_backend_issuer_import" "test" {
+  backend    = vault_mount.new.path
+  pem_bundle = vault_pki_secret_backend_root_sign_intermediate.cross.certificate
+}
+`, oldRoot, newRoot)
+}
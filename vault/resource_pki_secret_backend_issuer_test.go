@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendIssuer_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki-root")
+	resourceName := "vault_pki_secret_backend_issuer.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("PKI issuers require Vault 1.11 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerConfig(backend, "root-issuer", `"read-only", "issuing-certificates", "crl-signing"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIssuerName, "root-issuer"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLeafNotAfterBehavior, "truncate"),
+					resource.TestCheckResourceAttr(resourceName, "usage.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.0", "http://127.0.0.1:8200/v1/pki/ca"),
+					resource.TestCheckResourceAttr(resourceName, "crl_distribution_points.0", "http://127.0.0.1:8200/v1/pki/crl"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldIssuerID),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldKeyID),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldCertificate),
+				),
+			},
+			{
+				Config: testPkiSecretBackendIssuerConfig(backend, "renamed-issuer", `"read-only"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIssuerName, "renamed-issuer"),
+					resource.TestCheckResourceAttr(resourceName, "usage.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{consts.FieldIssuerRef},
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuerConfig(backend, name, usage string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test.example.com"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+
+  backend                 = vault_mount.test.path
+  issuer_ref              = "default"
+  issuer_name             = "%s"
+  usage                   = [%s]
+  leaf_not_after_behavior = "truncate"
+  issuing_certificates    = ["http://127.0.0.1:8200/v1/pki/ca"]
+  crl_distribution_points = ["http://127.0.0.1:8200/v1/pki/crl"]
+}
+`, backend, name, usage)
+}
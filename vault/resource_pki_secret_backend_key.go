@@ -0,0 +1,235 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendKeyCreate,
+		UpdateContext: pkiSecretBackendKeyUpdate,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendKeyRead),
+		DeleteContext: pkiSecretBackendKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the key belongs to.",
+				ForceNew:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Type of key to generate, one of internal, exported or kms. " +
+					"Conflicts with pem_bundle.",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms"}, false),
+				ExactlyOneOf: []string{consts.FieldType, consts.FieldPEMBundle},
+			},
+			consts.FieldPEMBundle: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key to import instead of generating one.",
+				ForceNew:    true,
+			},
+			consts.FieldKeyName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the key, must be unique within the mount.",
+			},
+			consts.FieldKeyType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The generated key type, one of rsa, ec or ed25519.",
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"rsa", "ec", "ed25519"}, false),
+				ConflictsWith: []string{consts.FieldPEMBundle},
+			},
+			consts.FieldKeyBits: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The number of bits of the generated key.",
+				ForceNew:      true,
+				ConflictsWith: []string{consts.FieldPEMBundle},
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The name of the previously configured managed key, requires type kms.",
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_id", consts.FieldPEMBundle},
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The ID of the previously configured managed key, requires type kms.",
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_name", consts.FieldPEMBundle},
+			},
+			consts.FieldKeyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the key, usable as key_ref.",
+			},
+			consts.FieldPrivateKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated private key, only set when type is exported.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+		return diag.Errorf("PKI keys require Vault %s or later", provider.VaultVersion111)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk(consts.FieldKeyName); ok {
+		data[consts.FieldKeyName] = v
+	}
+
+	var path string
+	if v, ok := d.GetOk(consts.FieldPEMBundle); ok {
+		path = backend + "/keys/import"
+		data[consts.FieldPEMBundle] = v
+	} else {
+		path = backend + "/keys/generate/" + d.Get(consts.FieldType).(string)
+		for _, k := range []string{
+			consts.FieldKeyType,
+			consts.FieldKeyBits,
+			"managed_key_name",
+			"managed_key_id",
+		} {
+			if v, ok := d.GetOk(k); ok {
+				data[k] = v
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Creating PKI key on %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return diag.Errorf("error creating PKI key on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created PKI key on %q", path)
+
+	var keyID string
+	if resp != nil {
+		keyID, _ = resp.Data[consts.FieldKeyID].(string)
+	}
+	if keyID == "" {
+		return diag.Errorf("no key ID returned creating PKI key on %q", path)
+	}
+
+	// the private key is only ever returned on creation of exported keys
+	if err := d.Set(consts.FieldPrivateKey, resp.Data[consts.FieldPrivateKey]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(pkiSecretBackendKeyPath(backend, keyID))
+
+	return pkiSecretBackendKeyRead(ctx, d, meta)
+}
+
+func pkiSecretBackendKeyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading PKI key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI key %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] PKI key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{
+		consts.FieldKeyID,
+		consts.FieldKeyName,
+		consts.FieldKeyType,
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	if d.HasChange(consts.FieldKeyName) {
+		data := map[string]interface{}{
+			consts.FieldKeyName: d.Get(consts.FieldKeyName),
+		}
+
+		log.Printf("[DEBUG] Updating PKI key %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return diag.Errorf("error updating PKI key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated PKI key %q", path)
+	}
+
+	return pkiSecretBackendKeyRead(ctx, d, meta)
+}
+
+func pkiSecretBackendKeyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting PKI key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting PKI key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted PKI key %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendKeyPath(backend, ref string) string {
+	return strings.Trim(backend, "/") + "/key/" + ref
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendKey_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("PKI keys require Vault 1.11 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendKeyConfig_generate(backend, "exported", "test-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeyName, "test-key"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeyType, "ec"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldKeyID),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldPrivateKey),
+				),
+			},
+			{
+				Config: testPkiSecretBackendKeyConfig_generate(backend, "exported", "renamed-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeyName, "renamed-key"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldPrivateKey),
+				),
+			},
+			{
+				Config: testPkiSecretBackendKeyConfig_generate(backend, "internal", "renamed-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeyName, "renamed-key"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPrivateKey, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendKey_import(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_key.test"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemBundle := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("PKI keys require Vault 1.11 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend    = vault_mount.test.path
+  key_name   = "imported-key"
+  pem_bundle = <<EOT
+%sEOT
+}
+`, backend, pemBundle),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeyName, "imported-key"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeyType, "ec"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldKeyID),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendKeyConfig_generate(backend, keyType, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend  = vault_mount.test.path
+  type     = "%s"
+  key_name = "%s"
+  key_type = "ec"
+  key_bits = 256
+}
+`, backend, keyType, name)
+}
@@ -354,6 +354,47 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Reference to the issuer used to sign certificates of the role.",
+			},
+			"cn_validations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Description: "Validations to run on the Common Name field of the certificate, " +
+					"any of email and hostname, or disabled.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"email", "hostname", "disabled"}, false),
+				},
+			},
+			"allowed_user_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Defines allowed User IDs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Set the Not After field of the certificate with specified date value, " +
+					"in the YYYY-MM-ddTHH:MM:SSZ format.",
+			},
+			"use_pss": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether or not to use PSS signatures over PKCS#1v1.5 signatures when a RSA-type issuer is used.",
+			},
+			"no_store_metadata": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to skip storing the metadata of the certificates issued against the role.",
+			},
 		},
 	}
 }
@@ -393,7 +434,6 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
-
 	iExtKeyUsageOids := d.Get("ext_key_usage_oids").([]interface{})
 	extKeyUsageOids := make([]string, 0, len(iExtKeyUsageOids))
 	for _, iUsage := range iExtKeyUsageOids {
@@ -467,6 +507,8 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["allowed_serial_numbers"] = allowedSerialNumbers
 	}
 
+	pkiSecretBackendRoleNewerFields(d, data)
+
 	log.Printf("[DEBUG] Creating role %s on PKI secret backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -605,6 +647,14 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("not_before_duration", notBeforeDuration)
 	d.Set("allowed_serial_numbers", allowedSerialNumbers)
 
+	for _, k := range pkiSecretBackendRoleNewerFieldNames {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -708,6 +758,8 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["allowed_serial_numbers"] = allowedSerialNumbers
 	}
 
+	pkiSecretBackendRoleNewerFields(d, data)
+
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error updating PKI secret backend role %q: %s", path, err)
@@ -749,6 +801,25 @@ func pkiSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool,
 	return secret != nil, nil
 }
 
+// pkiSecretBackendRoleNewerFieldNames are only supported by newer Vault versions,
+// they are only sent when configured.
+var pkiSecretBackendRoleNewerFieldNames = []string{
+	"issuer_ref",
+	"cn_validations",
+	"allowed_user_ids",
+	"not_after",
+	"use_pss",
+	"no_store_metadata",
+}
+
+func pkiSecretBackendRoleNewerFields(d *schema.ResourceData, data map[string]interface{}) {
+	for _, k := range pkiSecretBackendRoleNewerFieldNames {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+}
+
 func pkiSecretBackendRolePath(backend string, name string) string {
 	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
 }
@@ -250,7 +250,7 @@ func TestPkiSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "key_usage.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "key_usage.0", "DigitalSignature"),
 					resource.TestCheckResourceAttr(resourceName, "ext_key_usage.#", "0"),
-                                        resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.#", "0"
+					resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "use_csr_common_name", "true"),
 					resource.TestCheckResourceAttr(resourceName, "use_csr_sans", "true"),
 					resource.TestCheckResourceAttr(resourceName, "ou.0", "test"),
@@ -278,6 +278,67 @@ func TestPkiSecretBackendRole_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendRole_newerFields(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_pki_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.14") {
+				t.Skip("not_after requires Vault 1.14 or later")
+			}
+		},
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_newerFields(name, backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer_ref", "default"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.0", "email"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.0", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "not_after", "9999-12-31T23:59:59Z"),
+					resource.TestCheckResourceAttr(resourceName, "use_pss", "true"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_newerFields(name, backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "use_pss", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRoleConfig_newerFields(name, path string, usePSS bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_mount.pki.path
+  name             = "%s"
+  issuer_ref       = "default"
+  cn_validations   = ["email"]
+  allowed_user_ids = ["alice", "bob"]
+  not_after        = "9999-12-31T23:59:59Z"
+  use_pss          = %t
+}
+`, path, name, usePSS)
+}
+
 func testPkiSecretBackendRoleConfig_basic(name, path string, roleTTL, maxTTL int, policyIdentifiers string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "pki" {
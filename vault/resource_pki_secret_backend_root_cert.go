@@ -79,9 +79,25 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Type of root to create. Must be either \"exported\" or \"internal\".",
+				Description:  "Type of root to create. Must be either \"exported\", \"internal\", \"kms\" or \"existing\".",
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms", "existing"}, false),
+			},
+			"key_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the existing key to generate the root with, only used with type \"existing\".",
+				ForceNew:    true,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key the root was generated with.",
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer created for the root.",
 			},
 			"common_name": {
 				Type:        schema.TypeString,
@@ -326,9 +342,8 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 		"managed_key_id":       d.Get("managed_key_id").(string),
 	}
 
-	if rootType != "kms" {
-		data["key_type"] = d.Get("key_type").(string)
-		data["key_bits"] = d.Get("key_bits").(int)
+	if err := pkiSecretBackendKeySourceRequestData(d, meta, rootType, data); err != nil {
+		return err
 	}
 
 	if len(altNames) > 0 {
@@ -362,6 +377,8 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 	d.Set("issuing_ca", resp.Data["issuing_ca"])
 	d.Set("serial", resp.Data["serial_number"])
 	d.Set("serial_number", resp.Data["serial_number"])
+	d.Set("key_id", resp.Data["key_id"])
+	d.Set("issuer_id", resp.Data["issuer_id"])
 
 	d.SetId(path)
 
@@ -420,6 +437,31 @@ func pkiSecretBackendRootCertDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// pkiSecretBackendKeySourceRequestData sets the fields selecting the key of a
+// generated root or intermediate, depending on its type.
+func pkiSecretBackendKeySourceRequestData(d *schema.ResourceData, meta interface{}, keyType string, data map[string]interface{}) error {
+	switch keyType {
+	case "existing":
+		if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+			return fmt.Errorf("type \"existing\" requires Vault %s or later", provider.VaultVersion111)
+		}
+		keyRef, ok := d.GetOk("key_ref")
+		if !ok {
+			return fmt.Errorf("key_ref is required with type \"existing\"")
+		}
+		data["key_ref"] = keyRef.(string)
+	case "kms":
+		if data["managed_key_name"] == "" && data["managed_key_id"] == "" {
+			return fmt.Errorf("managed_key_name or managed_key_id is required with type \"kms\"")
+		}
+	default:
+		data["key_type"] = d.Get("key_type").(string)
+		data["key_bits"] = d.Get("key_bits").(int)
+	}
+
+	return nil
+}
+
 func pkiSecretBackendIntermediateSetSignedReadPath(backend string, rootType string) string {
 	return strings.Trim(backend, "/") + "/root/generate/" + strings.Trim(rootType, "/")
 }
@@ -136,6 +136,35 @@ func TestPkiSecretBackendRootCertificate_managedKeys(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendRootCertificate_existingKey(t *testing.T) {
+	path := acctest.RandomWithPrefix("pki")
+
+	resourceName := "vault_pki_secret_backend_root_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("PKI keys require Vault 1.11 or later")
+			}
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRootCertificateConfig_existingKey(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "existing"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id",
+						"vault_pki_secret_backend_key.test", consts.FieldKeyID),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+				),
+			},
+		},
+	})
+}
+
 func testPkiSecretBackendRootCertificateConfig_basic(path string) string {
 	config := fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -200,6 +229,31 @@ resource "vault_pki_secret_backend_root_cert" "test" {
 	return config
 }
 
+func testPkiSecretBackendRootCertificateConfig_existingKey(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend  = vault_mount.test.path
+  type     = "internal"
+  key_name = "root-key"
+  key_type = "ec"
+  key_bits = 256
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "existing"
+  key_ref     = vault_pki_secret_backend_key.test.key_id
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+`, path)
+}
+
 func Test_pkiSecretSerialNumberUpgradeV0(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -46,6 +46,12 @@ func pkiSecretBackendRootSignIntermediateResource() *schema.Resource {
 				Description: "The CSR.",
 				ForceNew:    true,
 			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the issuer to sign the intermediate with, defaults to the default issuer.",
+				ForceNew:    true,
+			},
 			"common_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -228,6 +234,12 @@ func pkiSecretBackendRootSignIntermediateCreate(d *schema.ResourceData, meta int
 	backend := d.Get("backend").(string)
 
 	path := pkiSecretBackendRootSignIntermediateCreatePath(backend)
+	if issuerRef, ok := d.GetOk("issuer_ref"); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+			return fmt.Errorf("issuer_ref requires Vault %s or later", provider.VaultVersion111)
+		}
+		path = pkiSecretBackendIssuerPath(backend, issuerRef.(string)) + "/sign-intermediate"
+	}
 
 	commonName := d.Get("common_name").(string)
 
@@ -0,0 +1,206 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func pkiSecretBackendSignVerbatimResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendSignVerbatimCreate,
+		Delete: pkiSecretBackendSignVerbatimDelete,
+		Update: func(data *schema.ResourceData, i interface{}) error {
+			return nil
+		},
+		Read:          ReadWrapper(pkiSecretBackendCertRead),
+		CustomizeDiff: pkiCertAutoRenewCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Name of the role whose key usage, ext key usage and TTL settings " +
+					"apply, other role settings are ignored.",
+				ForceNew: true,
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the issuer to sign the certificate with, defaults to the default issuer.",
+				ForceNew:    true,
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CSR, its contents are used verbatim.",
+				ForceNew:    true,
+			},
+			"key_usage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Key usages to set on the certificate, e.g. DigitalSignature.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ext_key_usage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Extended key usages to set on the certificate, e.g. ServerAuth.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ext_key_usage_oids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Extended key usage OIDs to set on the certificate.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Time to live.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The format of data.",
+				ForceNew:     true,
+				Default:      "pem",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"signature_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of bits to use in the signature algorithm.",
+				ForceNew:    true,
+			},
+			"auto_renew": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If enabled, a new certificate will be generated if the expiration is within min_seconds_remaining",
+			},
+			"min_seconds_remaining": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     604800,
+				Description: "Generate a new certificate when the expiration is within this number of seconds",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate.",
+			},
+			"issuing_ca": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate's serial number, hex formatted.",
+			},
+			"expiration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The certificate expiration as a Unix-style timestamp.",
+			},
+			"renew_pending": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "Initially false, and then set to true during refresh once " +
+					"the expiration is less than min_seconds_remaining in the future.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendSignVerbatimCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+
+	path := strings.Trim(backend, "/")
+	if issuerRef, ok := d.GetOk("issuer_ref"); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+			return fmt.Errorf("issuer_ref requires Vault %s or later", provider.VaultVersion111)
+		}
+		path = pkiSecretBackendIssuerPath(backend, issuerRef.(string))
+	}
+	path += "/sign-verbatim"
+	if name, ok := d.GetOk("name"); ok {
+		path += "/" + strings.Trim(name.(string), "/")
+	}
+
+	data := map[string]interface{}{
+		"csr":    d.Get("csr").(string),
+		"format": d.Get("format").(string),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v
+	}
+	if v, ok := d.GetOk("signature_bits"); ok {
+		data["signature_bits"] = v
+	}
+	for _, k := range []string{"key_usage", "ext_key_usage", "ext_key_usage_oids"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = util.ToStringArray(v.([]interface{}))
+		}
+	}
+
+	log.Printf("[DEBUG] Signing certificate verbatim on %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing certificate verbatim on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Signed certificate verbatim on %q", path)
+
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("issuing_ca", resp.Data["issuing_ca"])
+	d.Set("ca_chain", resp.Data["ca_chain"])
+	d.Set("serial_number", resp.Data["serial_number"])
+	d.Set("expiration", resp.Data["expiration"])
+
+	if err := pkiSecretBackendCertSynchronizeRenewPending(d); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/sign-verbatim/%s", strings.Trim(backend, "/"), resp.Data["serial_number"]))
+
+	return pkiSecretBackendCertRead(d, meta)
+}
+
+func pkiSecretBackendSignVerbatimDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package vault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendSignVerbatim_basic(t *testing.T) {
+	rootPath := acctest.RandomWithPrefix("pki-root")
+	resourceName := "vault_pki_secret_backend_sign_verbatim.test"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   "appliance.example.com",
+			Organization: []string{"Appliance Vendor"},
+		},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendSignVerbatimConfig(rootPath, csr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", rootPath),
+					resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration"),
+					testPKISignVerbatimCert(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testPKISignVerbatimCert(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		block, _ := pem.Decode([]byte(rs.Primary.Attributes["certificate"]))
+		if block == nil {
+			return fmt.Errorf("certificate is not PEM encoded")
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return err
+		}
+
+		if cert.Subject.CommonName != "appliance.example.com" {
+			return fmt.Errorf("expected CN %q, got %q", "appliance.example.com", cert.Subject.CommonName)
+		}
+		if len(cert.Subject.Organization) != 1 || cert.Subject.Organization[0] != "Appliance Vendor" {
+			return fmt.Errorf("expected the subject of the CSR to be used verbatim, got %v", cert.Subject)
+		}
+		if len(cert.ExtKeyUsage) != 1 || cert.ExtKeyUsage[0] != x509.ExtKeyUsageClientAuth {
+			return fmt.Errorf("expected ext key usage ClientAuth, got %v", cert.ExtKeyUsage)
+		}
+
+		return nil
+	}
+}
+
+func testPkiSecretBackendSignVerbatimConfig(rootPath, csr string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test-root.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_sign_verbatim" "test" {
+  backend       = vault_pki_secret_backend_root_cert.test.backend
+  csr           = <<EOT
+%sEOT
+  key_usage     = ["DigitalSignature"]
+  ext_key_usage = ["ClientAuth"]
+  ttl           = "1h"
+}
+`, rootPath, csr)
+}
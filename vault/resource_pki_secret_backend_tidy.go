@@ -0,0 +1,109 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// pkiSecretBackendTidyStatusFields are the fields of the tidy status exposed
+// as computed attributes.
+var pkiSecretBackendTidyStatusFields = map[string]schema.ValueType{
+	"state":                      schema.TypeString,
+	"error":                      schema.TypeString,
+	"time_started":               schema.TypeString,
+	"time_finished":              schema.TypeString,
+	"cert_store_deleted_count":   schema.TypeInt,
+	"revoked_cert_deleted_count": schema.TypeInt,
+	"missing_issuer_cert_count":  schema.TypeInt,
+}
+
+func pkiSecretBackendTidyResource() *schema.Resource {
+	s := pkiSecretBackendTidySchema(true)
+	s[consts.FieldKeepers] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Arbitrary map of values that, when changed, trigger a new tidy operation.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	for k, t := range pkiSecretBackendTidyStatusFields {
+		s[k] = &schema.Schema{
+			Type:        t,
+			Computed:    true,
+			Description: "Tidy status field " + k + " of the latest tidy operation.",
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendTidyCreate,
+		ReadContext:   ReadContextWrapper(pkiSecretBackendTidyRead),
+		DeleteContext: pkiSecretBackendTidyDelete,
+
+		Schema: s,
+	}
+}
+
+func pkiSecretBackendTidyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + "/tidy"
+	data := pkiSecretBackendTidyRequestData(d, pkiSecretBackendTidyFields)
+
+	log.Printf("[DEBUG] Starting PKI tidy on %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error starting PKI tidy on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Started PKI tidy on %q", path)
+
+	d.SetId(resource.UniqueId())
+
+	return pkiSecretBackendTidyRead(ctx, d, meta)
+}
+
+// pkiSecretBackendTidyRead refreshes the status of the latest tidy operation
+// of the backend, which runs in the background.
+func pkiSecretBackendTidyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Get(consts.FieldBackend).(string) + "/tidy-status"
+
+	log.Printf("[DEBUG] Reading PKI tidy status %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading PKI tidy status %q: %s", path, err)
+	}
+	if resp == nil {
+		return nil
+	}
+
+	for k := range pkiSecretBackendTidyStatusFields {
+		if v, ok := resp.Data[k]; ok && v != nil {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendTidyDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing PKI tidy %q from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const pluginCatalogPath = "sys/plugins/catalog"
+
+var (
+	pluginTypes = []string{"auth", "database", "secret"}
+
+	pluginFields = []string{
+		consts.FieldSHA256,
+		consts.FieldCommand,
+		consts.FieldArgs,
+		consts.FieldOCIImage,
+		consts.FieldRuntime,
+	}
+)
+
+func pluginResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pluginWrite,
+		ReadContext:   ReadContextWrapper(pluginRead),
+		UpdateContext: pluginWrite,
+		DeleteContext: pluginDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the plugin, one of 'auth', 'database' or 'secret'.",
+				ValidateFunc: validation.StringInSlice(pluginTypes, false),
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			consts.FieldVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Semantic version of the plugin, e.g. 'v1.0.0'. " +
+					"Multiple versions of the same plugin can be registered side by side.",
+			},
+			consts.FieldSHA256: {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "SHA256 sum of the plugin binary, or of the image " +
+					"when oci_image is set.",
+			},
+			consts.FieldCommand: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Command used to execute the plugin, relative to the plugin directory. " +
+					"Defaults to the plugin name when oci_image is set.",
+			},
+			consts.FieldArgs: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of arguments passed to the plugin command.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldEnv: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "List of environment variables in 'KEY=VALUE' form passed to the plugin.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldOCIImage: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OCI image to run the plugin in a container. Requires Vault 1.15+.",
+			},
+			consts.FieldRuntime: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the plugin runtime used to run the plugin container.",
+			},
+		},
+	}
+}
+
+func pluginWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	pluginType := d.Get(consts.FieldType).(string)
+	name := d.Get(consts.FieldName).(string)
+	version := d.Get(consts.FieldVersion).(string)
+	path := pluginCatalogEntryPath(pluginType, name)
+
+	data := map[string]interface{}{
+		consts.FieldEnv: d.Get(consts.FieldEnv),
+	}
+	for _, k := range pluginFields {
+		data[k] = d.Get(k)
+	}
+	if version != "" {
+		data[consts.FieldVersion] = version
+	}
+
+	log.Printf("[DEBUG] Registering plugin %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error registering plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Registered plugin %q", path)
+
+	d.SetId(pluginID(pluginType, name, version))
+
+	return pluginRead(ctx, d, meta)
+}
+
+func pluginRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	pluginType, name, version, err := parsePluginID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	path := pluginCatalogEntryPath(pluginType, name)
+
+	log.Printf("[DEBUG] Reading plugin %q", d.Id())
+	resp, err := client.Logical().ReadWithData(path, pluginVersionQuery(version))
+	if err != nil {
+		return diag.Errorf("error reading plugin %q: %s", d.Id(), err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Plugin %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	toSet := map[string]interface{}{
+		consts.FieldType:    pluginType,
+		consts.FieldName:    name,
+		consts.FieldVersion: version,
+	}
+	for _, k := range pluginFields {
+		if v, ok := resp.Data[k]; ok {
+			toSet[k] = v
+		}
+	}
+
+	for k, v := range toSet {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q on plugin, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func pluginDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	pluginType, name, version, err := parsePluginID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	path := pluginCatalogEntryPath(pluginType, name)
+
+	log.Printf("[DEBUG] Deregistering plugin %q", d.Id())
+	if _, err := client.Logical().DeleteWithData(path, pluginVersionQuery(version)); err != nil {
+		return diag.Errorf("error deregistering plugin %q: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Deregistered plugin %q", d.Id())
+
+	return nil
+}
+
+func pluginCatalogEntryPath(pluginType, name string) string {
+	return strings.Join([]string{pluginCatalogPath, pluginType, name}, "/")
+}
+
+func pluginVersionQuery(version string) map[string][]string {
+	if version == "" {
+		return nil
+	}
+
+	return map[string][]string{
+		consts.FieldVersion: {version},
+	}
+}
+
+// pluginID returns the resource ID in the form type/name[/version].
+func pluginID(pluginType, name, version string) string {
+	parts := []string{pluginType, name}
+	if version != "" {
+		parts = append(parts, version)
+	}
+
+	return strings.Join(parts, "/")
+}
+
+func parsePluginID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	switch len(parts) {
+	case 2:
+		return parts[0], parts[1], "", nil
+	case 3:
+		return parts[0], parts[1], parts[2], nil
+	default:
+		return "", "", "", fmt.Errorf("invalid plugin ID %q, expected type/name[/version]", id)
+	}
+}
@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pluginReloadResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pluginReloadCreate,
+		ReadContext:   ReadContextWrapper(pluginReloadRead),
+		DeleteContext: pluginReloadDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldPlugin: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Name of the plugin to reload, all mounts using the plugin are reloaded.",
+				ExactlyOneOf: []string{consts.FieldPlugin, consts.FieldMounts},
+			},
+			consts.FieldMounts: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of mount paths to reload the plugins of.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldScope: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Set to 'global' to reload the plugins on all nodes of the cluster " +
+					"and of performance replica clusters.",
+				ValidateFunc: validation.StringInSlice([]string{"global"}, false),
+			},
+			consts.FieldKeepers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: "Arbitrary map of values that, when changed, trigger a new reload, " +
+					"e.g. the version of the plugin.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldReloadID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the reload operation, only set for global reloads.",
+			},
+		},
+	}
+}
+
+func pluginReloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	input := &api.ReloadPluginInput{
+		Plugin: d.Get(consts.FieldPlugin).(string),
+		Scope:  d.Get(consts.FieldScope).(string),
+	}
+	if v, ok := d.GetOk(consts.FieldMounts); ok {
+		input.Mounts = expandStringSlice(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Reloading plugins, plugin=%q mounts=%v", input.Plugin, input.Mounts)
+	reloadID, err := client.Sys().ReloadPlugin(input)
+	if err != nil {
+		return diag.Errorf("error reloading plugins: %s", err)
+	}
+	log.Printf("[DEBUG] Reloaded plugins, reload_id=%q", reloadID)
+
+	if err := d.Set(consts.FieldReloadID, reloadID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return pluginReloadRead(ctx, d, meta)
+}
+
+// pluginReloadRead is a no-op, the reload is a one-off operation that has
+// no state to read back from Vault.
+func pluginReloadRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func pluginReloadDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing plugin reload %q from state", d.Id())
+
+	return nil
+}
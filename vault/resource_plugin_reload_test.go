@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestResourcePluginReload(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+	resourceName := "vault_plugin_reload.test"
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testPluginReloadConfig(path, "v1", true),
+				ExpectError: regexp.MustCompile(`"plugin": only one of`),
+			},
+			{
+				Config: testPluginReloadConfig(path, "v1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mounts.0", path),
+					resource.TestCheckResourceAttr(resourceName, "keepers.version", "v1"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(v string) error {
+						firstID = v
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testPluginReloadConfig(path, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.version", "v2"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(v string) error {
+						if v == firstID {
+							return fmt.Errorf("expected a new reload when %s change", consts.FieldKeepers)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testPluginReloadConfig(path, version string, withPlugin bool) string {
+	plugin := ""
+	if withPlugin {
+		plugin = `plugin = "kv"`
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+
+resource "vault_plugin_reload" "test" {
+  %s
+  mounts = [vault_mount.test.path]
+  keepers = {
+    version = "%s"
+  }
+}
+`, path, plugin, version)
+}
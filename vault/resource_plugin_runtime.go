@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const pluginRuntimeCatalogPath = "sys/plugins/runtimes/catalog"
+
+var pluginRuntimeFields = []string{
+	consts.FieldOCIRuntime,
+	consts.FieldCgroupParent,
+	consts.FieldCPUNanos,
+	consts.FieldMemoryBytes,
+	consts.FieldRootless,
+}
+
+func pluginRuntimeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(pluginRuntimeWrite, provider.VaultVersion115),
+		ReadContext:   ReadContextWrapper(pluginRuntimeRead),
+		UpdateContext: pluginRuntimeWrite,
+		DeleteContext: pluginRuntimeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "container",
+				Description:  "Type of the plugin runtime, currently only 'container' is supported.",
+				ValidateFunc: validation.StringInSlice([]string{"container"}, false),
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin runtime.",
+			},
+			consts.FieldOCIRuntime: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "OCI-compliant container runtime to use, e.g. 'runsc' for gVisor.",
+			},
+			consts.FieldCgroupParent: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Parent cgroup to set for each container.",
+			},
+			consts.FieldCPUNanos: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "CPU limit to set per container in nanos, 0 means no limit.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			consts.FieldMemoryBytes: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Memory limit to set per container in bytes, 0 means no limit.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			consts.FieldRootless: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether the container runtime is configured to run as " +
+					"a non-privileged user.",
+			},
+		},
+	}
+}
+
+func pluginRuntimeWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	runtimeType := d.Get(consts.FieldType).(string)
+	name := d.Get(consts.FieldName).(string)
+	path := pluginRuntimePath(runtimeType, name)
+
+	data := map[string]interface{}{}
+	for _, k := range pluginRuntimeFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Registering plugin runtime %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error registering plugin runtime %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Registered plugin runtime %q", path)
+
+	d.SetId(runtimeType + "/" + name)
+
+	return pluginRuntimeRead(ctx, d, meta)
+}
+
+func pluginRuntimeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	runtimeType, name, err := parsePluginRuntimeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	path := pluginRuntimePath(runtimeType, name)
+
+	log.Printf("[DEBUG] Reading plugin runtime %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading plugin runtime %q: %s", path, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Plugin runtime %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldType, runtimeType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldName, name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range pluginRuntimeFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on plugin runtime, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func pluginRuntimeDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	runtimeType, name, err := parsePluginRuntimeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	path := pluginRuntimePath(runtimeType, name)
+
+	log.Printf("[DEBUG] Deregistering plugin runtime %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deregistering plugin runtime %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deregistered plugin runtime %q", path)
+
+	return nil
+}
+
+func pluginRuntimePath(runtimeType, name string) string {
+	return strings.Join([]string{pluginRuntimeCatalogPath, runtimeType, name}, "/")
+}
+
+func parsePluginRuntimeID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid plugin runtime ID %q, expected type/name", id)
+	}
+
+	return parts[0], parts[1], nil
+}
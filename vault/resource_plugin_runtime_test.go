@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestResourcePluginRuntime(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-runtime")
+	resourceName := "vault_plugin_runtime.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.15") {
+				t.Skip("plugin runtimes require Vault 1.15 or later")
+			}
+		},
+		CheckDestroy: testPluginRuntimeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginRuntimeConfig(name, 1000000000, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, "container"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldOCIRuntime, "runsc"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCgroupParent, "/vault-plugins"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCPUNanos, "1000000000"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMemoryBytes, "0"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRootless, "true"),
+				),
+			},
+			{
+				Config: testPluginRuntimeConfig(name, 500000000, 268435456),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCPUNanos, "500000000"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMemoryBytes, "268435456"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testPluginRuntimeCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_runtime" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		runtimeType, name, err := parsePluginRuntimeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logical().Read(pluginRuntimePath(runtimeType, name))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin runtime %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testPluginRuntimeConfig(name string, cpuNanos, memoryBytes int) string {
+	return fmt.Sprintf(`
+resource "vault_plugin_runtime" "test" {
+  name          = "%s"
+  oci_runtime   = "runsc"
+  cgroup_parent = "/vault-plugins"
+  cpu_nanos     = %d
+  memory_bytes  = %d
+  rootless      = true
+}
+`, name, cpuNanos, memoryBytes)
+}
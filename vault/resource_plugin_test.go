@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// TestResourcePlugin requires a plugin binary in Vault's plugin directory,
+// its name and SHA256 sum are provided through the environment.
+func TestResourcePlugin(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "VAULT_TEST_PLUGIN_NAME", "VAULT_TEST_PLUGIN_SHA256")
+	name, sha256 := values[0], values[1]
+
+	resourceName := "vault_plugin.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("versioned plugins require Vault 1.12 or later")
+			}
+		},
+		CheckDestroy: testPluginCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginConfig(name, sha256, "v1.0.0", "-foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, "secret"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "v1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSHA256, sha256),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCommand, name),
+					resource.TestCheckResourceAttr(resourceName, "args.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "args.0", "-foo"),
+				),
+			},
+			{
+				Config: testPluginConfig(name, sha256, "v1.0.0", "-bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "v1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "args.0", "-bar"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil, consts.FieldEnv),
+		},
+	})
+}
+
+func testPluginCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		pluginType, name, version, err := parsePluginID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logical().ReadWithData(pluginCatalogEntryPath(pluginType, name), pluginVersionQuery(version))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testPluginConfig(name, sha256, version, arg string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "test" {
+  type    = "secret"
+  name    = "%s"
+  version = "%s"
+  sha256  = "%s"
+  command = "%s"
+  args    = ["%s"]
+  env     = ["FOO=bar"]
+}
+`, name, version, sha256, name, arg)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -176,13 +177,15 @@ func rabbitMQSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("name", pathPieces[len(pathPieces)-1])
 
 	if vhosts, ok := secret.Data["vhosts"]; ok && vhosts != nil {
-		if err := d.Set("vhost", flattenRabbitMQSecretBackendRoleVhost(vhosts.(map[string]interface{}))); err != nil {
+		flattened := flattenRabbitMQSecretBackendRoleVhost(vhosts.(map[string]interface{}), d.Get("vhost").([]interface{}))
+		if err := d.Set("vhost", flattened); err != nil {
 			return fmt.Errorf("error setting vhosts in state: %w", err)
 		}
 	}
 
 	if vhostTopics, ok := secret.Data["vhost_topics"]; ok && vhostTopics != nil {
-		if err := d.Set("vhost_topic", flattenRabbitMQSecretBackendRoleVhostTopics(vhostTopics.(map[string]interface{}))); err != nil {
+		flattened := flattenRabbitMQSecretBackendRoleVhostTopics(vhostTopics.(map[string]interface{}), d.Get("vhost_topic").([]interface{}))
+		if err := d.Set("vhost_topic", flattened); err != nil {
 			return fmt.Errorf("error setting vhosts topics in state: %w", err)
 		}
 	}
@@ -284,7 +287,7 @@ func expandRabbitMQSecretBackendRoleVhostTopic(vhost []interface{}) (string, err
 	return string(vhostsJSON), nil
 }
 
-func flattenRabbitMQSecretBackendRoleVhost(vhost map[string]interface{}) []map[string]interface{} {
+func flattenRabbitMQSecretBackendRoleVhost(vhost map[string]interface{}, prior []interface{}) []map[string]interface{} {
 	var vhosts []map[string]interface{}
 	for id, val := range vhost {
 		vals := val.(map[string]interface{})
@@ -296,24 +299,34 @@ func flattenRabbitMQSecretBackendRoleVhost(vhost map[string]interface{}) []map[s
 		})
 	}
 
+	sortRabbitMQSecretBackendRoleBlocks(vhosts, prior, "host")
 	return vhosts
 }
 
-func flattenRabbitMQSecretBackendRoleVhostTopics(vhostTopic map[string]interface{}) []map[string]interface{} {
+func flattenRabbitMQSecretBackendRoleVhostTopics(vhostTopic map[string]interface{}, prior []interface{}) []map[string]interface{} {
+	priorTopics := make(map[string][]interface{}, len(prior))
+	for _, p := range prior {
+		if v, ok := p.(map[string]interface{}); ok {
+			topics, _ := v["vhost"].([]interface{})
+			priorTopics[v["host"].(string)] = topics
+		}
+	}
+
 	var vhostTopics []map[string]interface{}
 	for id, val := range vhostTopic {
 		vals := val.(map[string]interface{})
 
 		vhostTopics = append(vhostTopics, map[string]interface{}{
 			"host":  id,
-			"vhost": flattenRabbitMQSecretBackendRoleVhostTopic(vals),
+			"vhost": flattenRabbitMQSecretBackendRoleVhostTopic(vals, priorTopics[id]),
 		})
 	}
 
+	sortRabbitMQSecretBackendRoleBlocks(vhostTopics, prior, "host")
 	return vhostTopics
 }
 
-func flattenRabbitMQSecretBackendRoleVhostTopic(topic map[string]interface{}) []map[string]interface{} {
+func flattenRabbitMQSecretBackendRoleVhostTopic(topic map[string]interface{}, prior []interface{}) []map[string]interface{} {
 	var topics []map[string]interface{}
 	for id, val := range topic {
 		vals := val.(map[string]interface{})
@@ -324,5 +337,32 @@ func flattenRabbitMQSecretBackendRoleVhostTopic(topic map[string]interface{}) []
 		})
 	}
 
+	sortRabbitMQSecretBackendRoleBlocks(topics, prior, "topic")
 	return topics
 }
+
+// sortRabbitMQSecretBackendRoleBlocks orders the flattened blocks the same way
+// as they were previously in the state, since Vault returns them as a map.
+// Blocks that were not in the state are sorted by key, after the known ones.
+func sortRabbitMQSecretBackendRoleBlocks(blocks []map[string]interface{}, prior []interface{}, key string) {
+	order := make(map[string]int, len(prior))
+	for i, p := range prior {
+		if v, ok := p.(map[string]interface{}); ok {
+			order[v[key].(string)] = i
+		}
+	}
+
+	sort.SliceStable(blocks, func(i, j int) bool {
+		a, b := blocks[i][key].(string), blocks[j][key].(string)
+		ai, aok := order[a]
+		bi, bok := order[b]
+		switch {
+		case aok && bok:
+			return ai < bi
+		case aok != bok:
+			return aok
+		default:
+			return a < b
+		}
+	})
+}
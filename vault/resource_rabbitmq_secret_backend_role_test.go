@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -151,6 +152,53 @@ func TestAccRabbitMQSecretBackendRole_topic(t *testing.T) {
 	})
 }
 
+func TestRabbitMQSecretBackendRoleFlattenVhostTopics(t *testing.T) {
+	vhostTopics := map[string]interface{}{
+		"/": map[string]interface{}{
+			"amq.topic": map[string]interface{}{"read": ".*", "write": ""},
+			"events":    map[string]interface{}{"read": "", "write": ".*"},
+		},
+		"dev": map[string]interface{}{
+			"amq.topic": map[string]interface{}{"read": ".*", "write": ".*"},
+		},
+		"prod": map[string]interface{}{
+			"amq.topic": map[string]interface{}{"read": ".*", "write": ""},
+		},
+	}
+
+	// the prior order is kept, new vhosts are sorted after the known ones
+	prior := []interface{}{
+		map[string]interface{}{
+			"host": "prod",
+		},
+		map[string]interface{}{
+			"host": "/",
+			"vhost": []interface{}{
+				map[string]interface{}{"topic": "events"},
+				map[string]interface{}{"topic": "amq.topic"},
+			},
+		},
+	}
+
+	actual := flattenRabbitMQSecretBackendRoleVhostTopics(vhostTopics, prior)
+
+	var hosts []string
+	for _, v := range actual {
+		hosts = append(hosts, v["host"].(string))
+	}
+	if expected := []string{"prod", "/", "dev"}; !reflect.DeepEqual(hosts, expected) {
+		t.Fatalf("expected hosts %v, actual %v", expected, hosts)
+	}
+
+	var topics []string
+	for _, v := range actual[1]["vhost"].([]map[string]interface{}) {
+		topics = append(topics, v["topic"].(string))
+	}
+	if expected := []string{"events", "amq.topic"}; !reflect.DeepEqual(topics, expected) {
+		t.Fatalf("expected topics %v, actual %v", expected, topics)
+	}
+}
+
 func testAccRabbitMQSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_rabbitmq_secret_backend_role" {
@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func raftSnapshotResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: raftSnapshotCreate,
+		ReadContext:   ReadContextWrapper(raftSnapshotRead),
+		DeleteContext: raftSnapshotDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldOutputPath: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Local path the snapshot is written to, " +
+					"an existing file is replaced once the snapshot succeeds.",
+			},
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new snapshot.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldSHA256: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 sum of the snapshot.",
+			},
+			consts.FieldSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the snapshot in bytes.",
+			},
+		},
+	}
+}
+
+func raftSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	outputPath := d.Get(consts.FieldOutputPath).(string)
+
+	// write to a temporary file first, so that a failed snapshot does not
+	// clobber an existing one at output_path
+	f, err := os.CreateTemp(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".*.tmp")
+	if err != nil {
+		return diag.Errorf("error creating temporary snapshot file for %q: %s", outputPath, err)
+	}
+
+	var done bool
+	defer func() {
+		if !done {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	h := sha256.New()
+	counter := &byteCounter{}
+
+	log.Printf("[DEBUG] Taking raft snapshot to %q", outputPath)
+	if err := client.Sys().RaftSnapshot(io.MultiWriter(f, h, counter)); err != nil {
+		return diag.Errorf("error taking raft snapshot: %s", err)
+	}
+
+	if err := f.Sync(); err != nil {
+		return diag.Errorf("error writing snapshot file %q: %s", f.Name(), err)
+	}
+
+	if err := f.Close(); err != nil {
+		return diag.Errorf("error closing snapshot file %q: %s", f.Name(), err)
+	}
+
+	if err := os.Rename(f.Name(), outputPath); err != nil {
+		return diag.Errorf("error moving snapshot file to %q: %s", outputPath, err)
+	}
+	done = true
+	log.Printf("[DEBUG] Took raft snapshot to %q", outputPath)
+
+	if err := d.Set(consts.FieldSHA256, hex.EncodeToString(h.Sum(nil))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldSize, counter.n); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return raftSnapshotRead(ctx, d, meta)
+}
+
+// raftSnapshotRead is a no-op, the snapshot is taken once and is not
+// tracked in Vault.
+func raftSnapshotRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func raftSnapshotDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing raft snapshot %q from state, the snapshot file is left in place", d.Id())
+
+	return nil
+}
+
+// byteCounter is an io.Writer that counts the bytes written to it.
+type byteCounter struct {
+	n int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -122,6 +123,7 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 			Type:        schema.TypeString,
 			Description: "Google service account key in JSON format.",
 			Optional:    true,
+			Sensitive:   true,
 		},
 		"google_endpoint": {
 			Type:        schema.TypeString,
@@ -147,6 +149,7 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 			Type:        schema.TypeString,
 			Description: "Azure account key.",
 			Optional:    true,
+			Sensitive:   true,
 		},
 		"azure_blob_environment": {
 			Type:        schema.TypeString,
@@ -158,6 +161,18 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 			Description: "Azure blob storage endpoint. This is typically only set when using a non-Azure implementation like Azurite.",
 			Optional:    true,
 		},
+		"azure_auth_mode": {
+			Type:         schema.TypeString,
+			Description:  "Azure authentication mode. One of \"shared\", \"managed\" or \"environment\".",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"shared", "managed", "environment"}, false),
+		},
+		"azure_client_id": {
+			Type:        schema.TypeString,
+			Description: "Azure client ID of the user-assigned managed identity, when azure_auth_mode=managed.",
+			Optional:    true,
+		},
 	}
 	return &schema.Resource{
 		Create: createOrUpdateSnapshotAgentConfigResource,
@@ -275,6 +290,15 @@ func buildConfigFromResourceData(d *schema.ResourceData) (map[string]interface{}
 		if v, ok := d.GetOk("azure_endpoint"); ok {
 			data["azure_endpoint"] = v
 		}
+		if v, ok := d.GetOk("azure_auth_mode"); ok {
+			data["azure_auth_mode"] = v
+		}
+		if v, ok := d.GetOk("azure_client_id"); ok {
+			if data["azure_auth_mode"] != "managed" {
+				return nil, errors.New("azure_client_id can only be set when azure_auth_mode is managed")
+			}
+			data["azure_client_id"] = v
+		}
 	}
 	return data, nil
 }
@@ -484,6 +508,18 @@ func readSnapshotAgentConfigResource(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if val, ok := resp.Data["azure_auth_mode"]; ok {
+		if err := d.Set("azure_auth_mode", val); err != nil {
+			return fmt.Errorf("error setting state key 'azure_auth_mode': %s", err)
+		}
+	}
+
+	if val, ok := resp.Data["azure_client_id"]; ok {
+		if err := d.Set("azure_client_id", val); err != nil {
+			return fmt.Errorf("error setting state key 'azure_client_id': %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -126,6 +126,40 @@ func TestAccRaftSnapshotAgentConfig_import(t *testing.T) {
 	})
 }
 
+func TestAccRaftSnapshotAgentConfig_azureManaged(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-raft-snapshot")
+	resourceName := "vault_raft_snapshot_agent_config.azure_backups"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.SkipTestEnvSet(t, "SKIP_RAFT_TESTS")
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.18") {
+				t.Skip("azure_auth_mode requires Vault 1.18 or later")
+			}
+		},
+		Providers:    testProviders,
+		CheckDestroy: testAccRaftSnapshotAgentConfigCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftSnapshotAgentConfig_azureManaged(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "azure-blob"),
+					resource.TestCheckResourceAttr(resourceName, "azure_container_name", "my-bucket"),
+					resource.TestCheckResourceAttr(resourceName, "azure_account_name", "azure-account-name"),
+					resource.TestCheckResourceAttr(resourceName, "azure_auth_mode", "managed"),
+					resource.TestCheckResourceAttr(resourceName, "azure_client_id", "00000000-0000-0000-0000-000000000000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccRaftSnapshotAgentConfigCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_raft_snapshot_agent_config" {
@@ -217,3 +251,18 @@ resource "vault_raft_snapshot_agent_config" "azure_backups" {
   azure_blob_environment = "azure-env"
 }`, name)
 }
+
+func testAccRaftSnapshotAgentConfig_azureManaged(name string) string {
+	return fmt.Sprintf(`
+resource "vault_raft_snapshot_agent_config" "azure_backups" {
+  name = "%s"
+  interval_seconds = 7200
+  retain = 1
+  path_prefix = "/path/in/bucket"
+  storage_type = "azure-blob"
+  azure_container_name = "my-bucket"
+  azure_account_name = "azure-account-name"
+  azure_auth_mode = "managed"
+  azure_client_id = "00000000-0000-0000-0000-000000000000"
+}`, name)
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccRaftSnapshot(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "vault.snap")
+	resourceName := "vault_raft_snapshot.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, "SKIP_RAFT_TESTS")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftSnapshotConfig(outputPath, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldOutputPath, outputPath),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldSHA256),
+					testAccRaftSnapshotCheckFile(resourceName, outputPath),
+				),
+			},
+			{
+				Config: testAccRaftSnapshotConfig(outputPath, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.change", "2"),
+					testAccRaftSnapshotCheckFile(resourceName, outputPath),
+				),
+			},
+		},
+	})
+}
+
+func testAccRaftSnapshotCheckFile(resourceName, outputPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		info, err := os.Stat(outputPath)
+		if err != nil {
+			return err
+		}
+
+		if size := fmt.Sprintf("%d", info.Size()); size != rs.Primary.Attributes[consts.FieldSize] {
+			return fmt.Errorf("expected snapshot size %s, got %s", rs.Primary.Attributes[consts.FieldSize], size)
+		}
+
+		return nil
+	}
+}
+
+func testAccRaftSnapshotConfig(outputPath, change string) string {
+	return fmt.Sprintf(`
+resource "vault_raft_snapshot" "test" {
+  output_path = "%s"
+  keepers = {
+    change = "%s"
+  }
+}
+`, outputPath, change)
+}
@@ -0,0 +1,129 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const perfPrimaryPathsFilterPath = "sys/replication/performance/primary/paths-filter"
+
+func replicationPerformancePathsFilterResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: replicationPerformancePathsFilterWrite,
+		ReadContext:   ReadContextWrapper(replicationPerformancePathsFilterRead),
+		UpdateContext: replicationPerformancePathsFilterWrite,
+		DeleteContext: replicationPerformancePathsFilterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldSecondaryID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the performance secondary the filter applies to.",
+			},
+			consts.FieldMode: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Whether the paths are an allow list or a deny list, one of 'allow' or 'deny'.",
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+			},
+			consts.FieldPaths: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The mount paths, optionally prefixed by a namespace, that are filtered.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func replicationPerformancePathsFilterPath(secondaryID string) string {
+	return perfPrimaryPathsFilterPath + "/" + secondaryID
+}
+
+func replicationPerformancePathsFilterWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	secondaryID := d.Get(consts.FieldSecondaryID).(string)
+	path := replicationPerformancePathsFilterPath(secondaryID)
+
+	data := map[string]interface{}{
+		consts.FieldMode:  d.Get(consts.FieldMode),
+		consts.FieldPaths: d.Get(consts.FieldPaths).(*schema.Set).List(),
+	}
+
+	log.Printf("[DEBUG] Writing paths filter %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing paths filter %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote paths filter %q", path)
+
+	d.SetId(secondaryID)
+
+	return replicationPerformancePathsFilterRead(ctx, d, meta)
+}
+
+func replicationPerformancePathsFilterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := replicationPerformancePathsFilterPath(d.Id())
+
+	log.Printf("[DEBUG] Reading paths filter %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading paths filter %q: %s", path, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] Paths filter %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldSecondaryID, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range []string{consts.FieldMode, consts.FieldPaths} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on paths filter %q, err=%s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func replicationPerformancePathsFilterDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := replicationPerformancePathsFilterPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting paths filter %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting paths filter %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted paths filter %q", path)
+
+	return nil
+}
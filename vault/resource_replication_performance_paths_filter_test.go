@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccReplicationPerformancePathsFilter(t *testing.T) {
+	secondaryID := acctest.RandomWithPrefix("tf-test-secondary")
+	mount := acctest.RandomWithPrefix("tf-test-kv")
+	resourceName := "vault_replication_performance_paths_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			// requires a Vault server that is a performance replication primary
+			testutil.SkipTestEnvUnset(t, "VAULT_TEST_PERF_PRIMARY")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationPerformancePathsFilterConfig(secondaryID, mount, "deny"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecondaryID, secondaryID),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMode, "deny"),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", mount+"/"),
+				),
+			},
+			{
+				Config: testAccReplicationPerformancePathsFilterConfig(secondaryID, mount, "allow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMode, "allow"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccReplicationPerformancePathsFilterConfig(secondaryID, mount, mode string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+
+resource "vault_replication_performance_secondary_token" "test" {
+  secondary_id = "%s"
+}
+
+resource "vault_replication_performance_paths_filter" "test" {
+  secondary_id = vault_replication_performance_secondary_token.test.secondary_id
+  mode         = "%s"
+  paths        = ["${vault_mount.test.path}/"]
+}
+`, mount, secondaryID, mode)
+}
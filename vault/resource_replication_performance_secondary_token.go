@@ -0,0 +1,171 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	perfPrimarySecondaryTokenPath  = "sys/replication/performance/primary/secondary-token"
+	perfPrimaryRevokeSecondaryPath = "sys/replication/performance/primary/revoke-secondary"
+	perfReplicationStatusPath      = "sys/replication/performance/status"
+)
+
+func replicationPerformanceSecondaryTokenResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: replicationPerformanceSecondaryTokenCreate,
+		ReadContext:   ReadContextWrapper(replicationPerformanceSecondaryTokenRead),
+		DeleteContext: replicationPerformanceSecondaryTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldSecondaryID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the performance secondary the token is generated for.",
+			},
+			consts.FieldTTL: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The TTL of the activation token, as a duration string, " +
+					"defaults to 30m on the Vault side.",
+			},
+			consts.FieldSecondaryPublicKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The public key of the secondary as returned by " +
+					"sys/replication/performance/secondary/generate-public-key, " +
+					"the token is then encrypted with it instead of being response wrapped.",
+			},
+			consts.FieldWrappedToken: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The response wrapped activation token.",
+			},
+			consts.FieldWrappingAccessor: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the response wrapped activation token.",
+			},
+			consts.FieldEncryptedToken: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The activation token encrypted with secondary_public_key.",
+			},
+		},
+	}
+}
+
+func replicationPerformanceSecondaryTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	secondaryID := d.Get(consts.FieldSecondaryID).(string)
+	data := map[string]interface{}{
+		consts.FieldID: secondaryID,
+	}
+
+	if v, ok := d.GetOk(consts.FieldTTL); ok {
+		data[consts.FieldTTL] = v
+	}
+
+	if v, ok := d.GetOk(consts.FieldSecondaryPublicKey); ok {
+		data[consts.FieldSecondaryPublicKey] = v
+	}
+
+	log.Printf("[DEBUG] Generating performance secondary token for %q", secondaryID)
+	resp, err := client.Logical().Write(perfPrimarySecondaryTokenPath, data)
+	if err != nil {
+		return diag.Errorf("error generating performance secondary token for %q: %s", secondaryID, err)
+	}
+	log.Printf("[DEBUG] Generated performance secondary token for %q", secondaryID)
+
+	if resp == nil {
+		return diag.Errorf("no response generating performance secondary token for %q", secondaryID)
+	}
+
+	d.SetId(secondaryID)
+
+	if resp.WrapInfo != nil {
+		if err := d.Set(consts.FieldWrappedToken, resp.WrapInfo.Token); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(consts.FieldWrappingAccessor, resp.WrapInfo.Accessor); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := d.Set(consts.FieldEncryptedToken, resp.Data[consts.FieldToken]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return replicationPerformanceSecondaryTokenRead(ctx, d, meta)
+}
+
+func replicationPerformanceSecondaryTokenRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading %q", perfReplicationStatusPath)
+	resp, err := client.Logical().Read(perfReplicationStatusPath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", perfReplicationStatusPath, err)
+	}
+
+	// the token itself cannot be read back, only check that the secondary is
+	// still known to the primary.
+	var known bool
+	if resp != nil {
+		if secondaries, ok := resp.Data["known_secondaries"].([]interface{}); ok {
+			for _, s := range secondaries {
+				if s == d.Id() {
+					known = true
+					break
+				}
+			}
+		}
+	}
+
+	if !known {
+		log.Printf("[WARN] Performance secondary %q not known to the primary, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldSecondaryID, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func replicationPerformanceSecondaryTokenDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Revoking performance secondary %q", d.Id())
+	if _, err := client.Logical().Write(perfPrimaryRevokeSecondaryPath, map[string]interface{}{
+		consts.FieldID: d.Id(),
+	}); err != nil {
+		return diag.Errorf("error revoking performance secondary %q: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Revoked performance secondary %q", d.Id())
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccReplicationPerformanceSecondaryToken(t *testing.T) {
+	secondaryID := acctest.RandomWithPrefix("tf-test-secondary")
+	resourceName := "vault_replication_performance_secondary_token.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			// requires a Vault server that is a performance replication primary
+			testutil.SkipTestEnvUnset(t, "VAULT_TEST_PERF_PRIMARY")
+		},
+		CheckDestroy: testAccReplicationPerformanceSecondaryTokenCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationPerformanceSecondaryTokenConfig(secondaryID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecondaryID, secondaryID),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTTL, "10m"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldWrappedToken),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldWrappingAccessor),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationPerformanceSecondaryTokenCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_replication_performance_secondary_token" {
+			continue
+		}
+
+		client, err := provider.GetClient(rs.Primary, testProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logical().Read(perfReplicationStatusPath)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			continue
+		}
+
+		secondaries, _ := resp.Data["known_secondaries"].([]interface{})
+		for _, secondary := range secondaries {
+			if secondary == rs.Primary.ID {
+				return fmt.Errorf("performance secondary %q still known to the primary", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccReplicationPerformanceSecondaryTokenConfig(secondaryID string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_performance_secondary_token" "test" {
+  secondary_id = "%s"
+  ttl          = "10m"
+}
+`, secondaryID)
+}
@@ -0,0 +1,114 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const sealWrapRewrapPath = "sys/sealwrap/rewrap"
+
+func sealWrapRewrapResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: sealWrapRewrapCreate,
+		ReadContext:   ReadContextWrapper(sealWrapRewrapRead),
+		DeleteContext: sealWrapRewrapDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new rewrap.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldIsRunning: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a rewrap is in progress.",
+			},
+			consts.FieldEntriesProcessed: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of entries processed by the last rewrap.",
+			},
+			consts.FieldEntriesSucceeded: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of entries successfully rewrapped by the last rewrap.",
+			},
+			consts.FieldEntriesFailed: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of entries that failed to be rewrapped by the last rewrap.",
+			},
+		},
+	}
+}
+
+func sealWrapRewrapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Starting seal wrap rewrap")
+	if _, err := client.Logical().Write(sealWrapRewrapPath, nil); err != nil {
+		return diag.Errorf("error starting seal wrap rewrap: %s", err)
+	}
+	log.Printf("[DEBUG] Started seal wrap rewrap")
+
+	d.SetId(resource.UniqueId())
+
+	return sealWrapRewrapRead(ctx, d, meta)
+}
+
+// sealWrapRewrapRead refreshes the progress of the rewrap, the
+// resource itself is never removed from state.
+func sealWrapRewrapRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading %q", sealWrapRewrapPath)
+	resp, err := client.Logical().Read(sealWrapRewrapPath)
+	if err != nil {
+		return diag.Errorf("error reading %q: %s", sealWrapRewrapPath, err)
+	}
+
+	if resp == nil {
+		return nil
+	}
+
+	if err := d.Set(consts.FieldIsRunning, resp.Data[consts.FieldIsRunning]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries, _ := resp.Data["entries"].(map[string]interface{})
+	for k, v := range map[string]string{
+		consts.FieldEntriesProcessed: "processed",
+		consts.FieldEntriesSucceeded: "succeeded",
+		consts.FieldEntriesFailed:    "failed",
+	} {
+		if err := d.Set(k, entries[v]); err != nil {
+			return diag.Errorf("error setting state key %q on seal wrap rewrap, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func sealWrapRewrapDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing seal wrap rewrap %q from state", d.Id())
+
+	return nil
+}
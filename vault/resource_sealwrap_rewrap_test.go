@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccSealWrapRewrap(t *testing.T) {
+	resourceName := "vault_sealwrap_rewrap.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			// requires a Vault server with an HSM or KMS seal that supports seal wrapping
+			testutil.SkipTestEnvUnset(t, "VAULT_TEST_SEAL_WRAP")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSealWrapRewrapConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldIsRunning),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldEntriesProcessed),
+				),
+			},
+			{
+				Config: testAccSealWrapRewrapConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSealWrapRewrapConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "vault_sealwrap_rewrap" "test" {
+  keepers = {
+    rotation = "%s"
+  }
+}
+`, rotation)
+}
@@ -53,6 +53,32 @@ func sshSecretBackendCAResource() *schema.Resource {
 				Computed:    true,
 				Description: "Public key part the SSH CA key pair; required if generate_signing_key is false.",
 			},
+			"key_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Type of the generated signing key, e.g. ssh-rsa, ssh-ed25519 or ec-sha2-nistp256.",
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Number of bits of the generated signing key, only used with RSA and ECDSA keys.",
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The name of the managed key backing the CA.",
+				ConflictsWith: []string{"managed_key_id"},
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The ID of the managed key backing the CA.",
+				ConflictsWith: []string{"managed_key_name"},
+			},
 		},
 	}
 }
@@ -75,6 +101,19 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	if publicKey, ok := d.Get("public_key").(string); ok {
 		data["public_key"] = publicKey
 	}
+	for _, k := range []string{"key_type", "key_bits"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	for _, k := range []string{"managed_key_name", "managed_key_id"} {
+		if v, ok := d.GetOk(k); ok {
+			if !provider.IsAPISupported(meta, provider.VaultVersion118) {
+				return fmt.Errorf("%s requires Vault %s or later", k, provider.VaultVersion118)
+			}
+			data[k] = v
+		}
+	}
 
 	log.Printf("[DEBUG] Writing CA information on SSH backend %q", backend)
 	_, err := client.Logical().Write(backend+"/config/ca", data)
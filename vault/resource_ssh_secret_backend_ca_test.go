@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -44,6 +45,27 @@ func TestAccSSHSecretBackendCA_provided(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendCA_keyType(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendCADestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendCAConfigKeyType(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHSecretBackendCACheck(backend),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_ca.test", "key_type", "ec-sha2-nistp384"),
+					resource.TestMatchResourceAttr("vault_ssh_secret_backend_ca.test", "public_key",
+						regexp.MustCompile("^ecdsa-sha2-nistp384 ")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSHSecretBackend_import(t *testing.T) {
 	backend := "ssh-" + acctest.RandString(10)
 	resource.Test(t, resource.TestCase{
@@ -101,6 +123,20 @@ resource "vault_ssh_secret_backend_ca" "test" {
 }`, backend)
 }
 
+func testAccSSHSecretBackendCAConfigKeyType(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.test.path
+  generate_signing_key = true
+  key_type             = "ec-sha2-nistp384"
+}`, backend)
+}
+
 func testAccSSHSecretBackendCAConfigProvided(backend string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
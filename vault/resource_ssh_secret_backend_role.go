@@ -71,6 +71,11 @@ func sshSecretBackendRoleResource() *schema.Resource {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"allowed_domains_template": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Specifies if allowed_domains can be declared using identity template policies.",
+		},
 		"cidr_list": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -100,6 +105,11 @@ func sshSecretBackendRoleResource() *schema.Resource {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"default_user_template": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Specifies if default_user can be declared using identity template policies.",
+		},
 		"key_id_format": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -247,6 +257,13 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["default_user"] = v.(string)
 	}
 
+	// only sent when configured, older Vault versions do not support them
+	for _, k := range []string{"allowed_domains_template", "default_user_template"} {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
 	if v, ok := d.GetOk("key_id_format"); ok {
 		data["key_id_format"] = v.(string)
 	}
@@ -365,6 +382,7 @@ func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		"default_critical_options", "allowed_users_template",
 		"allowed_users", "default_user", "key_id_format",
 		"max_ttl", "ttl", "algorithm_signer",
+		"allowed_domains_template", "default_user_template",
 	}
 
 	for _, k := range fields {
@@ -115,6 +115,46 @@ func TestAccSSHSecretBackendRole(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendRole_template(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test/ssh")
+	name := acctest.RandomWithPrefix("tf-test-role")
+
+	resourceName := "vault_ssh_secret_backend_role.test_role"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("allowed_domains_template requires Vault 1.12 or later")
+			}
+		},
+		CheckDestroy: testAccSSHSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendRoleConfig_template(name, backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_domains_template", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_user_template", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_user", "{{identity.entity.name}}"),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendRoleConfig_template(name, backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_domains_template", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_user_template", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSSHSecretBackendRoleOTP_basic(t *testing.T) {
 	backend := acctest.RandomWithPrefix("tf-test/ssh")
 	name := acctest.RandomWithPrefix("tf-test-role")
@@ -253,6 +293,27 @@ resource "vault_ssh_secret_backend_role" "test_role" {
 	return config
 }
 
+func testAccSSHSecretBackendRoleConfig_template(name, path string, template bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test_role" {
+  name                     = "%s"
+  backend                  = vault_mount.example.path
+  key_type                 = "ca"
+  allow_host_certificates  = true
+  allow_user_certificates  = true
+  allowed_domains          = "{{identity.entity.metadata.domain}}"
+  allowed_domains_template = %t
+  default_user             = "{{identity.entity.name}}"
+  default_user_template    = %t
+}
+`, path, name, template, template)
+}
+
 func testAccSSHSecretBackendRoleOTPConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "example" {
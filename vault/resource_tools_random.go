@@ -0,0 +1,113 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const toolsRandomPath = "sys/tools/random"
+
+func toolsRandomResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: toolsRandomCreate,
+		ReadContext:   ReadContextWrapper(toolsRandomRead),
+		DeleteContext: toolsRandomDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, generate new random bytes.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      32,
+				Description:  "The number of bytes to generate.",
+				ValidateFunc: validation.IntBetween(1, 128*1024),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "base64",
+				Description:  "The output encoding, either base64 or hex.",
+				ValidateFunc: validation.StringInSlice([]string{"base64", "hex"}, false),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The source of the random bytes, one of platform, seal or all. " +
+					"Defaults to platform.",
+				ValidateFunc: validation.StringInSlice([]string{"platform", "seal", "all"}, false),
+			},
+			"random_bytes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The random bytes, encoded according to format.",
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func toolsRandomCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := toolsRandomPath
+	if v, ok := d.GetOk("source"); ok {
+		path = fmt.Sprintf("%s/%s", toolsRandomPath, v.(string))
+	}
+
+	data := map[string]interface{}{
+		"bytes":  d.Get("bytes").(int),
+		"format": d.Get("format").(string),
+	}
+
+	log.Printf("[DEBUG] Generating random bytes from %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return diag.Errorf("error generating random bytes from %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("empty response from %q", path)
+	}
+	log.Printf("[DEBUG] Generated random bytes from %q", path)
+
+	if err := d.Set("random_bytes", resp.Data["random_bytes"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return toolsRandomRead(ctx, d, meta)
+}
+
+// toolsRandomRead is a no-op, the random bytes are generated once on create.
+func toolsRandomRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func toolsRandomDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing random bytes %q from state", d.Id())
+
+	return nil
+}
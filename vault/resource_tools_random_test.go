@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccToolsRandom(t *testing.T) {
+	resourceName := "vault_tools_random.test"
+
+	var prev string
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccToolsRandomConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "random_bytes",
+						regexp.MustCompile(`^[0-9a-f]{32}$`)),
+					testAccToolsRandomCheckChanged(resourceName, &prev),
+				),
+			},
+			{
+				// unchanged keepers keep the random bytes
+				Config:   testAccToolsRandomConfig("1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccToolsRandomConfig("2"),
+				Check:  testAccToolsRandomCheckChanged(resourceName, &prev),
+			},
+		},
+	})
+}
+
+// testAccToolsRandomCheckChanged checks that the random bytes changed since
+// the previous check.
+func testAccToolsRandomCheckChanged(resourceName string, prev *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		v := rs.Primary.Attributes["random_bytes"]
+		if v == *prev {
+			return fmt.Errorf("expected random bytes to change, got %q", v)
+		}
+		*prev = v
+
+		return nil
+	}
+}
+
+func testAccToolsRandomConfig(keeper string) string {
+	return fmt.Sprintf(`
+resource "vault_tools_random" "test" {
+  bytes  = 16
+  format = "hex"
+  keepers = {
+    rotation = "%s"
+  }
+}
+`, keeper)
+}
@@ -8,14 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
+const transitCacheConfigSuffix = "/cache-config"
+
 func transitSecretBackendCacheConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: transitSecretBackendCacheConfigUpdate,
 		Update: transitSecretBackendCacheConfigUpdate,
 		Read:   ReadWrapper(transitSecretBackendCacheConfigRead),
 		Delete: transitSecretBackendCacheConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -29,8 +35,22 @@ func transitSecretBackendCacheConfig() *schema.Resource {
 			},
 			"size": {
 				Type:        schema.TypeInt,
-				Description: "Number of cache entries. A size of 0 mean unlimited.",
+				Description: "Number of cache entries. A size of 0 mean unlimited, otherwise it must be at least 10.",
 				Required:    true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v != 0 && v < 10 {
+						errs = append(errs, fmt.Errorf("%q must be 0 or at least 10, got: %d", key, v))
+					}
+					return
+				},
+			},
+			"disable_upsert": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: "If set, keys are not created on the fly by encrypt requests, " +
+					"they must be created beforehand.",
 			},
 		},
 	}
@@ -44,7 +64,7 @@ func transitSecretBackendCacheConfigUpdate(d *schema.ResourceData, meta interfac
 
 	size := d.Get("size").(int)
 
-	backend := d.Get("backend").(string) + "/cache-config"
+	backend := d.Get("backend").(string) + transitCacheConfigSuffix
 
 	log.Printf("[DEBUG] Setting transit cache size to: %d", size)
 
@@ -58,6 +78,18 @@ func transitSecretBackendCacheConfigUpdate(d *schema.ResourceData, meta interfac
 	log.Printf("[DEBUG] Set transit cache size")
 	d.SetId(backend)
 
+	if v, ok := d.GetOkExists("disable_upsert"); ok && d.HasChange("disable_upsert") {
+		keysConfig := d.Get("backend").(string) + "/config/keys"
+
+		log.Printf("[DEBUG] Setting transit keys config disable_upsert to: %t", v.(bool))
+		if _, err := client.Logical().Write(keysConfig, map[string]interface{}{
+			"disable_upsert": v.(bool),
+		}); err != nil {
+			return fmt.Errorf("error writing transit keys config: %v", err)
+		}
+		log.Printf("[DEBUG] Set transit keys config")
+	}
+
 	data = map[string]interface{}{
 		"mounts": []string{d.Get("backend").(string) + "/"},
 	}
@@ -88,7 +120,26 @@ func transitSecretBackendCacheConfigRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
-	d.Set("size", secret.Data["size"])
+	if err := d.Set("backend", strings.TrimSuffix(backend, transitCacheConfigSuffix)); err != nil {
+		return err
+	}
+
+	if err := d.Set("size", secret.Data["size"]); err != nil {
+		return err
+	}
+
+	// the keys config is only available on newer Vault versions
+	keysConfig := strings.TrimSuffix(backend, transitCacheConfigSuffix) + "/config/keys"
+	resp, err := client.Logical().Read(keysConfig)
+	if err != nil && !util.Is404(err) {
+		return fmt.Errorf("error reading transit keys config: %v", err)
+	}
+
+	if resp != nil {
+		if err := d.Set("disable_upsert", resp.Data["disable_upsert"]); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -38,6 +39,15 @@ func TestAccTransitCacheConfig(t *testing.T) {
 				Config: testAccTransitCacheConfig(name, 0),
 				Check:  resource.TestCheckResourceAttr("vault_transit_secret_cache_config.cfg", "size", "0"),
 			},
+			{
+				ResourceName:      "vault_transit_secret_cache_config.cfg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccTransitCacheConfig(name, 5),
+				ExpectError: regexp.MustCompile(`must be 0 or at least 10`),
+			},
 			{
 				Config: testAccTransitCacheConfigRemoved(name),
 				Check:  testAccTransitCacheConfigCheckRemoved,
@@ -46,6 +56,48 @@ func TestAccTransitCacheConfig(t *testing.T) {
 	})
 }
 
+func TestAccTransitCacheConfig_disableUpsert(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-cache-config")
+	resourceName := "vault_transit_secret_cache_config.cfg"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.15") {
+				t.Skip("disable_upsert requires Vault 1.15 or later")
+			}
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitCacheConfigDisableUpsert(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "size", "100"),
+					resource.TestCheckResourceAttr(resourceName, "disable_upsert", "true"),
+				),
+			},
+			{
+				Config: testAccTransitCacheConfigDisableUpsert(name, false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "disable_upsert", "false"),
+			},
+		},
+	})
+}
+
+func testAccTransitCacheConfigDisableUpsert(entityName string, disableUpsert bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_cache_config" "cfg" {
+  backend        = vault_mount.transit.path
+  size           = 100
+  disable_upsert = %t
+}`, entityName, disableUpsert)
+}
+
 func testAccTransitCacheConfigCheckDestroyed(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_transit_secret_cache_config" {
@@ -0,0 +1,222 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const transitCiphertextPrefix = "vault:v"
+
+func transitRewrapResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: transitRewrapCreate,
+		ReadContext:   ReadContextWrapper(transitRewrapRead),
+		DeleteContext: transitRewrapDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+				ForceNew:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key the ciphertexts were encrypted with.",
+				ForceNew:    true,
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to rewrap to, defaults to the latest version.",
+				ForceNew:    true,
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the context for key derivation, used for all ciphertexts.",
+				ForceNew:    true,
+			},
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new rewrap.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ciphertexts": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Map of ciphertexts to rewrap.",
+				ExactlyOneOf: []string{"ciphertexts", "kv_mount"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"kv_mount": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Path where the KV-V2 engine holding the ciphertexts is mounted. The values " +
+					"of the secret that are transit ciphertexts are rewrapped and written back as a new version.",
+				ExactlyOneOf: []string{"ciphertexts", "kv_mount"},
+				RequiredWith: []string{"kv_name"},
+			},
+			"kv_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Full name of the KV-V2 secret holding the ciphertexts.",
+				RequiredWith: []string{"kv_mount"},
+			},
+			"rewrapped_ciphertexts": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the rewrapped ciphertexts, with the same keys as the input.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func transitRewrapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	var (
+		ciphertexts = map[string]string{}
+		kvData      map[string]interface{}
+		kvVersion   interface{}
+	)
+
+	kvPath := getKVV2Path(d.Get("kv_mount").(string), d.Get("kv_name").(string), consts.FieldData)
+	if _, ok := d.GetOk("kv_mount"); ok {
+		log.Printf("[DEBUG] Reading ciphertexts from %q", kvPath)
+		resp, err := client.Logical().Read(kvPath)
+		if err != nil {
+			return diag.Errorf("error reading ciphertexts from %q: %s", kvPath, err)
+		}
+		if resp == nil {
+			return diag.Errorf("no secret found at %q", kvPath)
+		}
+
+		kvData, _ = resp.Data["data"].(map[string]interface{})
+		for k, v := range kvData {
+			if s, ok := v.(string); ok && strings.HasPrefix(s, transitCiphertextPrefix) {
+				ciphertexts[k] = s
+			}
+		}
+
+		if metadata, ok := resp.Data["metadata"].(map[string]interface{}); ok {
+			kvVersion = metadata[consts.FieldVersion]
+		}
+	} else {
+		for k, v := range d.Get("ciphertexts").(map[string]interface{}) {
+			ciphertexts[k] = v.(string)
+		}
+	}
+
+	rewrapped, err := transitRewrapCiphertexts(client, d, ciphertexts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// write the rewrapped ciphertexts back, failing if the secret was
+	// changed since it was read
+	if kvData != nil && len(rewrapped) > 0 {
+		for k, v := range rewrapped {
+			kvData[k] = v
+		}
+
+		log.Printf("[DEBUG] Writing rewrapped ciphertexts to %q", kvPath)
+		if _, err := client.Logical().Write(kvPath, map[string]interface{}{
+			"data": kvData,
+			"options": map[string]interface{}{
+				"cas": kvVersion,
+			},
+		}); err != nil {
+			return diag.Errorf("error writing rewrapped ciphertexts to %q: %s", kvPath, err)
+		}
+		log.Printf("[DEBUG] Wrote rewrapped ciphertexts to %q", kvPath)
+	}
+
+	if err := d.Set("rewrapped_ciphertexts", rewrapped); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return transitRewrapRead(ctx, d, meta)
+}
+
+// transitRewrapCiphertexts rewraps the ciphertexts in a single batch request.
+func transitRewrapCiphertexts(client *api.Client, d *schema.ResourceData, ciphertexts map[string]string) (map[string]string, error) {
+	rewrapped := map[string]string{}
+	if len(ciphertexts) == 0 {
+		return rewrapped, nil
+	}
+
+	names := make([]string, 0, len(ciphertexts))
+	for k := range ciphertexts {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	batch := make([]map[string]interface{}, 0, len(names))
+	for _, k := range names {
+		batch = append(batch, map[string]interface{}{
+			"ciphertext": ciphertexts[k],
+			"context":    transitEncodeContext(d.Get("context").(string)),
+		})
+	}
+
+	path := fmt.Sprintf("%s/rewrap/%s", d.Get("backend").(string), d.Get("key").(string))
+	payload := map[string]interface{}{
+		"batch_input": batch,
+		"key_version": d.Get("key_version").(int),
+	}
+
+	log.Printf("[DEBUG] Rewrapping %d ciphertexts with %q", len(batch), path)
+	results, err := transitBatchWrite(client, path, payload, len(batch))
+	if err != nil {
+		return nil, fmt.Errorf("error rewrapping ciphertexts with %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rewrapped %d ciphertexts with %q", len(batch), path)
+
+	for i, r := range results {
+		v, _ := r["ciphertext"].(string)
+		rewrapped[names[i]] = v
+	}
+
+	return rewrapped, nil
+}
+
+// transitRewrapRead is a no-op, the ciphertexts are rewrapped once on create.
+func transitRewrapRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func transitRewrapDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing rewrap %q from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTransitRewrap(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+	resourceName := "vault_transit_rewrap.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitRewrapBaseConfig(backend, mount),
+				// an application stores a ciphertext, then the key is rotated
+				Check: func(_ *terraform.State) error {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					resp, err := client.Logical().Write(backend+"/encrypt/test", map[string]interface{}{
+						"plaintext": base64.StdEncoding.EncodeToString([]byte("foo")),
+					})
+					if err != nil {
+						return err
+					}
+
+					if _, err := client.Logical().Write(getKVV2Path(mount, name, consts.FieldData),
+						map[string]interface{}{
+							"data": map[string]interface{}{
+								"foo":   resp.Data["ciphertext"],
+								"plain": "text",
+							},
+						}); err != nil {
+						return err
+					}
+
+					_, err = client.Logical().Write(backend+"/keys/test/rotate", nil)
+					return err
+				},
+			},
+			{
+				Config: testTransitRewrapConfig(backend, mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rewrapped_ciphertexts.%", "1"),
+					resource.TestMatchResourceAttr(resourceName, "rewrapped_ciphertexts.foo",
+						regexp.MustCompile(`^vault:v2:`)),
+					testTransitRewrapCheckKV(mount, name),
+				),
+			},
+		},
+	})
+}
+
+func testTransitRewrapCheckKV(mount, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+		resp, err := client.Logical().Read(getKVV2Path(mount, name, consts.FieldData))
+		if err != nil {
+			return err
+		}
+
+		data := resp.Data["data"].(map[string]interface{})
+		if v := data["foo"].(string); !strings.HasPrefix(v, "vault:v2:") {
+			return fmt.Errorf("expected rewrapped ciphertext in KV secret, got %q", v)
+		}
+		if v := data["plain"].(string); v != "text" {
+			return fmt.Errorf("expected plain value to be unchanged, got %q", v)
+		}
+
+		return nil
+	}
+}
+
+func testTransitRewrapBaseConfig(backend, mount string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.transit.path
+  name             = "test"
+  deletion_allowed = true
+}
+`, kvV2MountConfig(mount), backend)
+}
+
+func testTransitRewrapConfig(backend, mount, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_transit_rewrap" "test" {
+  backend  = vault_mount.transit.path
+  key      = vault_transit_secret_backend_key.test.name
+  kv_mount = vault_mount.kvv2.path
+  kv_name  = "%s"
+  keepers = {
+    latest_version = vault_transit_secret_backend_key.test.latest_version
+  }
+}
+`, testTransitRewrapBaseConfig(backend, mount), name)
+}
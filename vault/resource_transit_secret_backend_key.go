@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -89,14 +90,43 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				Computed:      true,
 				Description:   "Amount of time the key should live before being automatically rotated. A value of 0 disables automatic rotation for the key.",
 				ConflictsWith: []string{"auto_rotate_interval"},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v != 0 && v < 3600 {
+						errs = append(errs, fmt.Errorf("%q must be 0 or at least 3600 seconds, got: %d", key, v))
+					}
+					return
+				},
 			},
 			"type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "Specifies the type of key to create. The currently-supported types are: aes128-gcm96, aes256-gcm96, chacha20-poly1305, ed25519, ecdsa-p256, ecdsa-p384, ecdsa-p521, rsa-2048, rsa-3072, rsa-4096",
+				Description:  "Specifies the type of key to create. The currently-supported types are: aes128-gcm96, aes256-gcm96, chacha20-poly1305, ed25519, ecdsa-p256, ecdsa-p384, ecdsa-p521, hmac, rsa-2048, rsa-3072, rsa-4096, managed_key",
 				ForceNew:     true,
 				Default:      "aes256-gcm96",
-				ValidateFunc: validation.StringInSlice([]string{"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519", "ecdsa-p256", "ecdsa-p384", "ecdsa-p521", "rsa-2048", "rsa-3072", "rsa-4096"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519", "ecdsa-p256", "ecdsa-p384", "ecdsa-p521", "hmac", "rsa-2048", "rsa-3072", "rsa-4096", "managed_key"}, false),
+			},
+			consts.FieldKeySize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The key size in bytes for algorithms that allow variable key sizes. Currently only applicable to HMAC; this value must be between 32 and 512.",
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(32, 512),
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The name of the managed key to use when the key type is managed_key.",
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_id"},
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The UUID of the managed key to use when the key type is managed_key.",
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_name"},
 			},
 			"keys": {
 				Type:        schema.TypeList,
@@ -206,7 +236,7 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 	autoRotatePeriod := getTransitAutoRotatePeriod(d)
 	configData := map[string]interface{}{
 		"min_decryption_version": d.Get("min_decryption_version").(int),
-		"min_encryption_versoin": d.Get("min_encryption_version").(int),
+		"min_encryption_version": d.Get("min_encryption_version").(int),
 		"deletion_allowed":       d.Get("deletion_allowed").(bool),
 		"exportable":             d.Get("exportable").(bool),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
@@ -220,6 +250,19 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 		"auto_rotate_period":    autoRotatePeriod,
 	}
 
+	if v, ok := d.GetOk(consts.FieldKeySize); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion112) {
+			return fmt.Errorf("%q requires Vault %s or later", consts.FieldKeySize, provider.VaultVersion112)
+		}
+		data[consts.FieldKeySize] = v.(int)
+	}
+
+	for _, k := range []string{"managed_key_name", "managed_key_id"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
 	log.Printf("[DEBUG] Creating encryption key %s on transit secret backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -384,7 +427,14 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err := set(autoRotatePeriodField, "auto_rotate_period"); err != nil {
-		return nil
+		return err
+	}
+
+	// only returned for key types with a variable key size
+	if v, ok := secret.Data[consts.FieldKeySize]; ok {
+		if err := d.Set(consts.FieldKeySize, v); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitSecretBackendKeyBackupResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: transitSecretBackendKeyBackupCreate,
+		ReadContext:   ReadContextWrapper(transitSecretBackendKeyBackupRead),
+		DeleteContext: transitSecretBackendKeyBackupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to back up, the key must allow plaintext backups.",
+				ForceNew:    true,
+			},
+			consts.FieldKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new backup.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"backup": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The backup of the key, including all of its versions.",
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := fmt.Sprintf("%s/backup/%s", backend, name)
+
+	log.Printf("[DEBUG] Backing up key from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error backing up key from %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("no key found at %q", path)
+	}
+	log.Printf("[DEBUG] Backed up key from %q", path)
+
+	if err := d.Set("backup", resp.Data["backup"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return transitSecretBackendKeyBackupRead(ctx, d, meta)
+}
+
+// transitSecretBackendKeyBackupRead is a no-op, the backup is taken once on
+// create and refreshed when the keepers change.
+func transitSecretBackendKeyBackupRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func transitSecretBackendKeyBackupDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing key backup %q from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTransitSecretBackendKeyBackupRestore(t *testing.T) {
+	primary := acctest.RandomWithPrefix("transit")
+	dr := acctest.RandomWithPrefix("transit-dr")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyBackupRestoreConfig(primary, dr, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vault_transit_secret_backend_key_backup.test", "backup"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key_restore.test", "id",
+						fmt.Sprintf("%s/keys/%s", dr, name)),
+					// ciphertext from the primary backend decrypts with the restored key
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyBackupRestoreConfig(primary, dr, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "primary" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_mount" "dr" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend                = vault_mount.primary.path
+  name                   = "%s"
+  deletion_allowed       = true
+  exportable             = true
+  allow_plaintext_backup = true
+}
+
+resource "vault_transit_secret_backend_key_backup" "test" {
+  backend = vault_mount.primary.path
+  name    = vault_transit_secret_backend_key.test.name
+  keepers = {
+    latest_version = vault_transit_secret_backend_key.test.latest_version
+  }
+}
+
+resource "vault_transit_secret_backend_key_restore" "test" {
+  backend = vault_mount.dr.path
+  name    = vault_transit_secret_backend_key.test.name
+  backup  = vault_transit_secret_backend_key_backup.test.backup
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_mount.primary.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_mount.dr.path
+  key        = vault_transit_secret_backend_key_restore.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+}
+`, primary, dr, name)
+}
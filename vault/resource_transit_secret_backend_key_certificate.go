@@ -0,0 +1,140 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitSecretBackendKeyCertificateResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: transitSecretBackendKeyCertificateWrite,
+		UpdateContext: transitSecretBackendKeyCertificateWrite,
+		ReadContext:   ReadContextWrapper(transitSecretBackendKeyCertificateRead),
+		DeleteContext: transitSecretBackendKeyCertificateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the signing key the certificate chain belongs to.",
+				ForceNew:    true,
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version of the key the certificate chain belongs to, defaults to the latest version.",
+				ForceNew:    true,
+			},
+			"certificate_chain": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "PEM encoded certificate chain, the first certificate must be " +
+					"the certificate of the key's public key.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyCertificateWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion115) {
+		return diag.Errorf("transit key certificates require Vault %s or later", provider.VaultVersion115)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := transitSecretBackendKeyPath(d.Get("backend").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"certificate_chain": d.Get("certificate_chain").(string),
+	}
+	if v, ok := d.GetOk("version"); ok {
+		data["version"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Setting certificate chain of key %q", path)
+	if _, err := client.Logical().Write(path+"/set-certificate", data); err != nil {
+		return diag.Errorf("error setting certificate chain of key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Set certificate chain of key %q", path)
+
+	d.SetId(path)
+
+	return transitSecretBackendKeyCertificateRead(ctx, d, meta)
+}
+
+func transitSecretBackendKeyCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading key from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read key from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	version := d.Get("version").(int)
+	if version == 0 {
+		if v, ok := resp.Data["latest_version"].(json.Number); ok {
+			latest, err := v.Int64()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			version = int(latest)
+		}
+	}
+
+	keys, _ := resp.Data["keys"].(map[string]interface{})
+	key, ok := keys[strconv.Itoa(version)].(map[string]interface{})
+	if !ok {
+		log.Printf("[WARN] Version %d of key %q not found, removing from state", version, path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("version", version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := key["certificate_chain"]; ok {
+		if err := d.Set("certificate_chain", v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// transitSecretBackendKeyCertificateDelete only removes the resource from
+// state, Vault does not support removing a key's certificate chain.
+func transitSecretBackendKeyCertificateDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing certificate chain of key %q from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTransitSecretBackendKeyCertificate(t *testing.T) {
+	transit := acctest.RandomWithPrefix("transit")
+	pki := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.15") {
+				t.Skip("transit key certificates require Vault 1.15 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyCertificateConfig(transit, pki, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("vault_transit_secret_backend_key_csr.test", "csr",
+						regexp.MustCompile(`^-----BEGIN CERTIFICATE REQUEST-----`)),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key_certificate.test", "version", "1"),
+					resource.TestMatchResourceAttr("vault_transit_secret_backend_key_certificate.test", "certificate_chain",
+						regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)),
+				),
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyCertificateConfig(transit, pki, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_mount" "pki" {
+  path                  = "%s"
+  type                  = "pki"
+  max_lease_ttl_seconds = 86400
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.transit.path
+  name             = "%s"
+  type             = "ecdsa-p256"
+  deletion_allowed = true
+}
+
+resource "vault_transit_secret_backend_key_csr" "test" {
+  backend = vault_mount.transit.path
+  name    = vault_transit_secret_backend_key.test.name
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.pki.path
+  type        = "internal"
+  common_name = "Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_root_sign_intermediate" "test" {
+  depends_on  = [vault_pki_secret_backend_root_cert.test]
+  backend     = vault_mount.pki.path
+  csr         = vault_transit_secret_backend_key_csr.test.csr
+  common_name = "Transit Signing Key"
+}
+
+resource "vault_transit_secret_backend_key_certificate" "test" {
+  backend           = vault_mount.transit.path
+  name              = vault_transit_secret_backend_key.test.name
+  certificate_chain = "${vault_pki_secret_backend_root_sign_intermediate.test.certificate}\n${vault_pki_secret_backend_root_sign_intermediate.test.issuing_ca}"
+}
+`, transit, pki, name)
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitSecretBackendKeyCSRResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: transitSecretBackendKeyCSRCreate,
+		ReadContext:   ReadContextWrapper(transitSecretBackendKeyCSRRead),
+		DeleteContext: transitSecretBackendKeyCSRDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the signing key to generate the CSR for.",
+				ForceNew:    true,
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use, defaults to the latest version.",
+				ForceNew:    true,
+			},
+			"csr_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "PEM encoded CSR used as a template, its subject and extensions " +
+					"are copied to the generated CSR.",
+				ForceNew: true,
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded CSR signed by the transit key.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyCSRCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion115) {
+		return diag.Errorf("transit key CSRs require Vault %s or later", provider.VaultVersion115)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := transitSecretBackendKeyPath(d.Get("backend").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("version"); ok {
+		data["version"] = v.(int)
+	}
+	if v, ok := d.GetOk("csr_template"); ok {
+		data["csr"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Generating CSR for key %q", path)
+	resp, err := client.Logical().Write(path+"/csr", data)
+	if err != nil {
+		return diag.Errorf("error generating CSR for key %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("empty response generating CSR for key %q", path)
+	}
+	log.Printf("[DEBUG] Generated CSR for key %q", path)
+
+	if err := d.Set("csr", resp.Data["csr"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path + "/csr")
+
+	return transitSecretBackendKeyCSRRead(ctx, d, meta)
+}
+
+// transitSecretBackendKeyCSRRead is a no-op, the CSR is generated once on
+// create.
+func transitSecretBackendKeyCSRRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func transitSecretBackendKeyCSRDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing CSR %q from state", d.Id())
+
+	return nil
+}
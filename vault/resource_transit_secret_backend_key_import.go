@@ -0,0 +1,419 @@
+package vault
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var transitImportHashFunctions = map[string]crypto.Hash{
+	"SHA1":   crypto.SHA1,
+	"SHA224": crypto.SHA224,
+	"SHA256": crypto.SHA256,
+	"SHA384": crypto.SHA384,
+	"SHA512": crypto.SHA512,
+}
+
+func transitSecretBackendKeyImportResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: transitSecretBackendKeyImportCreate,
+		ReadContext:   ReadContextWrapper(transitSecretBackendKeyImportRead),
+		UpdateContext: transitSecretBackendKeyImportUpdate,
+		DeleteContext: transitSecretBackendKeyImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the resource belongs to.",
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to import.",
+				ForceNew:    true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aes256-gcm96",
+				Description: "The type of the imported key, one of aes128-gcm96, aes256-gcm96, " +
+					"chacha20-poly1305, ed25519, ecdsa-p256, ecdsa-p384, ecdsa-p521, hmac, " +
+					"rsa-2048, rsa-3072 or rsa-4096.",
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519",
+					"ecdsa-p256", "ecdsa-p384", "ecdsa-p521", "hmac",
+					"rsa-2048", "rsa-3072", "rsa-4096",
+				}, false),
+			},
+			"key_material": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Base64 encoded key material to import. Symmetric keys are the raw key " +
+					"bytes, asymmetric keys are the PKCS#8 DER encoded private key. The key material " +
+					"is wrapped with the backend's wrapping key before it is sent to Vault.",
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"hash_function": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SHA256",
+				Description:  "The hash function used for the RSA-OAEP step of the key wrapping.",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA224", "SHA256", "SHA384", "SHA512"}, false),
+			},
+			"allow_rotation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set, Vault may rotate the key. Changing key_material of a key that " +
+					"allows rotation imports it as a new version, otherwise the key is replaced.",
+				ForceNew: true,
+			},
+			"derived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies if key derivation is to be used.",
+				ForceNew:    true,
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base64 encoded context for key derivation, required if derived is set.",
+				ForceNew:    true,
+			},
+			"exportable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables the key to be exportable. Once set, this cannot be disabled.",
+			},
+			"allow_plaintext_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, enables taking backup of the key in plaintext format. Once set, this cannot be disabled.",
+			},
+			"deletion_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies if the key is allowed to be deleted.",
+			},
+			"auto_rotate_period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Amount of seconds the key should live before being automatically rotated. A value of 0 disables automatic rotation.",
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version in the keyring.",
+			},
+		},
+		// new key material is imported as a new version when rotation is allowed
+		CustomizeDiff: customdiff.ForceNewIf("key_material", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+			return d.HasChange("key_material") && !d.Get("allow_rotation").(bool)
+		}),
+	}
+}
+
+func transitSecretBackendKeyImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+		return diag.Errorf("importing transit keys requires Vault %s or later", provider.VaultVersion111)
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := transitSecretBackendKeyPath(backend, name)
+
+	ciphertext, err := transitWrapKeyMaterial(client, backend, d.Get("key_material").(string), d.Get("hash_function").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{
+		"ciphertext":             ciphertext,
+		"hash_function":          d.Get("hash_function").(string),
+		"type":                   d.Get("type").(string),
+		"allow_rotation":         d.Get("allow_rotation").(bool),
+		"derived":                d.Get("derived").(bool),
+		"exportable":             d.Get("exportable").(bool),
+		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
+		"auto_rotate_period":     d.Get("auto_rotate_period").(int),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Importing key %q", path)
+	if _, err := client.Logical().Write(path+"/import", data); err != nil {
+		return diag.Errorf("error importing key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Imported key %q", path)
+
+	d.SetId(path)
+
+	if d.Get("deletion_allowed").(bool) {
+		if err := transitSecretBackendKeyImportConfig(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return transitSecretBackendKeyImportRead(ctx, d, meta)
+}
+
+func transitSecretBackendKeyImportRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading key from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read key from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	fields := []string{
+		"type", "derived", "exportable", "allow_plaintext_backup",
+		"deletion_allowed", "auto_rotate_period", "latest_version",
+	}
+	for _, k := range fields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if v, ok := resp.Data["imported_key_allow_rotation"]; ok {
+		if err := d.Set("allow_rotation", v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func transitSecretBackendKeyImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	if d.HasChange("key_material") {
+		ciphertext, err := transitWrapKeyMaterial(client, d.Get("backend").(string),
+			d.Get("key_material").(string), d.Get("hash_function").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		data := map[string]interface{}{
+			"ciphertext":    ciphertext,
+			"hash_function": d.Get("hash_function").(string),
+		}
+
+		log.Printf("[DEBUG] Importing new version of key %q", path)
+		if _, err := client.Logical().Write(path+"/import_version", data); err != nil {
+			return diag.Errorf("error importing new version of key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Imported new version of key %q", path)
+	}
+
+	if err := transitSecretBackendKeyImportConfig(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return transitSecretBackendKeyImportRead(ctx, d, meta)
+}
+
+func transitSecretBackendKeyImportDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted key %q", path)
+
+	return nil
+}
+
+func transitSecretBackendKeyImportConfig(client *api.Client, d *schema.ResourceData) error {
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"exportable":             d.Get("exportable").(bool),
+		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
+		"deletion_allowed":       d.Get("deletion_allowed").(bool),
+		"auto_rotate_period":     d.Get("auto_rotate_period").(int),
+	}
+
+	log.Printf("[DEBUG] Updating configuration of key %q", path)
+	if _, err := client.Logical().Write(path+"/config", data); err != nil {
+		return fmt.Errorf("error updating configuration of key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated configuration of key %q", path)
+
+	return nil
+}
+
+// transitWrappingKey returns the PEM encoded public wrapping key of the
+// transit backend.
+func transitWrappingKey(client *api.Client, backend string) (string, error) {
+	path := backend + "/wrapping_key"
+
+	log.Printf("[DEBUG] Reading wrapping key from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading wrapping key from %q: %s", path, err)
+	}
+	if resp == nil {
+		return "", fmt.Errorf("no wrapping key found at %q", path)
+	}
+
+	v, ok := resp.Data["public_key"].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected public_key %v at %q", resp.Data["public_key"], path)
+	}
+
+	return v, nil
+}
+
+// transitWrapKeyMaterial wraps the base64 encoded key material for import as
+// described in https://developer.hashicorp.com/vault/docs/secrets/transit/key-wrapping-guide:
+// the key material is wrapped with an ephemeral AES-256 key using AES-KWP,
+// the ephemeral key is wrapped with the backend's wrapping key using RSA-OAEP.
+func transitWrapKeyMaterial(client *api.Client, backend, keyMaterial, hashFunction string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(keyMaterial)
+	if err != nil {
+		return "", fmt.Errorf("error decoding key_material: %s", err)
+	}
+
+	wrappingKeyPEM, err := transitWrappingKey(client, backend)
+	if err != nil {
+		return "", err
+	}
+
+	block, _ := pem.Decode([]byte(wrappingKeyPEM))
+	if block == nil {
+		return "", fmt.Errorf("error decoding wrapping key PEM")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("error parsing wrapping key: %s", err)
+	}
+
+	wrappingKey, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("unexpected wrapping key type %T", pub)
+	}
+
+	ephemeralKey := make([]byte, 32)
+	if _, err := rand.Read(ephemeralKey); err != nil {
+		return "", fmt.Errorf("error generating ephemeral key: %s", err)
+	}
+
+	wrappedKey, err := transitWrapKWP(ephemeralKey, key)
+	if err != nil {
+		return "", err
+	}
+
+	hash, ok := transitImportHashFunctions[hashFunction]
+	if !ok {
+		return "", fmt.Errorf("unsupported hash function %q", hashFunction)
+	}
+
+	wrappedEphemeralKey, err := rsa.EncryptOAEP(hash.New(), rand.Reader, wrappingKey, ephemeralKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("error wrapping ephemeral key: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(append(wrappedEphemeralKey, wrappedKey...)), nil
+}
+
+// transitWrapKWP wraps plaintext with kek using the AES key wrap with
+// padding algorithm from RFC 5649.
+func transitWrapKWP(kek, plaintext []byte) ([]byte, error) {
+	if len(plaintext) == 0 {
+		return nil, fmt.Errorf("key material must not be empty")
+	}
+
+	cipher, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	// the alternative initial value holds the length of the plaintext
+	aiv := make([]byte, 8)
+	copy(aiv, []byte{0xa6, 0x59, 0x59, 0xa6})
+	binary.BigEndian.PutUint32(aiv[4:], uint32(len(plaintext)))
+
+	padded := make([]byte, (len(plaintext)+7)/8*8)
+	copy(padded, plaintext)
+
+	if len(padded) == 8 {
+		out := make([]byte, 16)
+		cipher.Encrypt(out, append(aiv, padded...))
+		return out, nil
+	}
+
+	n := len(padded) / 8
+	a := aiv
+	r := padded
+	b := make([]byte, 16)
+	for j := 0; j < 6; j++ {
+		for i := 0; i < n; i++ {
+			copy(b, a)
+			copy(b[8:], r[i*8:(i+1)*8])
+			cipher.Encrypt(b, b)
+
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(b[:8])^t)
+			copy(r[i*8:(i+1)*8], b[8:])
+		}
+	}
+
+	return append(a, r...), nil
+}
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTransitSecretBackendKeyImport(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key_import.test"
+
+	keyMaterial := func() string {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			t.Fatal(err)
+		}
+		return base64.StdEncoding.EncodeToString(key)
+	}
+	key1, key2 := keyMaterial(), keyMaterial()
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.11") {
+				t.Skip("key import requires Vault 1.11 or later")
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyImportConfig(backend, name, key1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "aes256-gcm96"),
+					resource.TestCheckResourceAttr(resourceName, "allow_rotation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_allowed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+			{
+				// new key material is imported as a new version
+				Config: testTransitSecretBackendKeyImportConfig(backend, name, key2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyImportConfig(backend, name, keyMaterial string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key_import" "test" {
+  backend          = vault_mount.transit.path
+  name             = "%s"
+  key_material     = "%s"
+  allow_rotation   = true
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_mount.transit.path
+  key       = vault_transit_secret_backend_key_import.test.name
+  plaintext = "foo"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_mount.transit.path
+  key        = vault_transit_secret_backend_key_import.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+}
+`, backend, name, keyMaterial)
+}
+
+func TestTransitWrapKWP(t *testing.T) {
+	// test vectors from RFC 5649 section 6
+	kek, _ := hex.DecodeString("5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8")
+
+	tests := []struct {
+		name      string
+		plaintext string
+		want      string
+	}{
+		{
+			name:      "20 octets",
+			plaintext: "c37b7e6492584340bed12207808941155068f738",
+			want:      "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a",
+		},
+		{
+			name:      "7 octets",
+			plaintext: "466f7250617369",
+			want:      "afbeb0f07dfbf5419200f2ccb50bb24f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plaintext, _ := hex.DecodeString(tt.plaintext)
+			got, err := transitWrapKWP(kek, plaintext)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("transitWrapKWP() = %x, want %s", got, tt.want)
+			}
+		})
+	}
+}
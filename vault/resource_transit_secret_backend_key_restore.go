@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitSecretBackendKeyRestoreResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: transitSecretBackendKeyRestoreCreate,
+		ReadContext:   ReadContextWrapper(transitSecretBackendKeyRestoreRead),
+		DeleteContext: transitSecretBackendKeyRestoreDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend to restore the key to.",
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the restored key.",
+				ForceNew:    true,
+			},
+			"backup": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The backup of the key, as returned by the backup endpoint.",
+				Sensitive:   true,
+				ForceNew:    true,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, an existing key with the same name is overwritten.",
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := fmt.Sprintf("%s/restore/%s", backend, name)
+
+	data := map[string]interface{}{
+		"backup": d.Get("backup").(string),
+		"force":  d.Get("force").(bool),
+	}
+
+	log.Printf("[DEBUG] Restoring key to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error restoring key to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Restored key to %q", path)
+
+	d.SetId(transitSecretBackendKeyPath(backend, name))
+
+	return transitSecretBackendKeyRestoreRead(ctx, d, meta)
+}
+
+func transitSecretBackendKeyRestoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading key from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read key from %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+// transitSecretBackendKeyRestoreDelete leaves the restored key in Vault,
+// it can only be deleted once deletion_allowed is set on the key.
+func transitSecretBackendKeyRestoreDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing restored key %q from state", d.Id())
+
+	return nil
+}
@@ -149,6 +149,67 @@ func TestTransitSecretBackendKey_rsa4096(t *testing.T) {
 	})
 }
 
+func TestTransitSecretBackendKey_hmac(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.12") {
+				t.Skip("key_size requires Vault 1.12 or later")
+			}
+		},
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyConfig_hmac(name, backend, 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "hmac"),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "64"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rotate_period", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "min_encryption_version", "0"),
+				),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_hmac(name, backend, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key_size", "64"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rotate_period", "0"),
+				),
+			},
+			{
+				Config:      testTransitSecretBackendKeyConfig_hmac(name, backend, 60),
+				ExpectError: regexp.MustCompile(`must be 0 or at least 3600 seconds`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyConfig_hmac(name, path string, period int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend            = vault_mount.transit.path
+  name               = "%s"
+  deletion_allowed   = true
+  type               = "hmac"
+  key_size           = 64
+  auto_rotate_period = %d
+}
+`, path, name, period)
+}
+
 func testTransitSecretBackendKeyConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
@@ -0,0 +1,264 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	uiCustomMessagesPath = "sys/config/ui/custom-messages"
+
+	uiCustomMessageTypeBanner = "banner"
+	uiCustomMessageTypeModal  = "modal"
+)
+
+func uiCustomMessageResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(uiCustomMessageCreate, provider.VaultVersion116),
+		ReadContext:   ReadContextWrapper(uiCustomMessageRead),
+		UpdateContext: uiCustomMessageUpdate,
+		DeleteContext: uiCustomMessageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldTitle: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the custom message.",
+			},
+			consts.FieldMessage: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The contents of the custom message.",
+			},
+			consts.FieldAuthenticated: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "If true, the message is displayed after a user has logged in, " +
+					"otherwise it is displayed on the login page.",
+			},
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     uiCustomMessageTypeBanner,
+				Description: "The display type of the custom message. Allowed values are banner and modal.",
+				ValidateFunc: validation.StringInSlice([]string{
+					uiCustomMessageTypeBanner,
+					uiCustomMessageTypeModal,
+				}, false),
+			},
+			consts.FieldStartTime: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The time, in RFC3339 format, when the message becomes active.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			consts.FieldEndTime: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The time, in RFC3339 format, when the message expires. " +
+					"If not set the message never expires.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			consts.FieldLink: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A hyperlink to be included with the message.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldTitle: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The text of the hyperlink.",
+						},
+						consts.FieldHref: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The URL of the hyperlink.",
+						},
+					},
+				},
+			},
+			consts.FieldOptions: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional display options for the custom message.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func uiCustomMessageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Creating UI custom message %q", d.Get(consts.FieldTitle))
+	resp, err := client.Logical().Write(uiCustomMessagesPath, uiCustomMessageRequestData(d))
+	if err != nil {
+		return diag.Errorf("error creating UI custom message: %s", err)
+	}
+
+	if resp == nil || resp.Data == nil {
+		return diag.Errorf("empty response from Vault creating UI custom message")
+	}
+
+	id, ok := resp.Data[consts.FieldID].(string)
+	if !ok || id == "" {
+		return diag.Errorf("response from Vault did not contain a custom message ID")
+	}
+
+	d.SetId(id)
+	log.Printf("[DEBUG] Created UI custom message %q", id)
+
+	return uiCustomMessageRead(ctx, d, meta)
+}
+
+func uiCustomMessageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiCustomMessagePath(d.Id())
+
+	log.Printf("[DEBUG] Updating UI custom message %q", path)
+	if _, err := client.Logical().Write(path, uiCustomMessageRequestData(d)); err != nil {
+		return diag.Errorf("error updating UI custom message %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated UI custom message %q", path)
+
+	return uiCustomMessageRead(ctx, d, meta)
+}
+
+func uiCustomMessageRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiCustomMessagePath(d.Id())
+
+	log.Printf("[DEBUG] Reading UI custom message %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading UI custom message %q: %s", path, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] UI custom message %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{
+		consts.FieldTitle,
+		consts.FieldAuthenticated,
+		consts.FieldType,
+		consts.FieldStartTime,
+		consts.FieldOptions,
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on UI custom message, err=%s", k, err)
+		}
+	}
+
+	// Vault returns a zero time value when no end_time was provided.
+	endTime, _ := resp.Data[consts.FieldEndTime].(string)
+	if strings.HasPrefix(endTime, "0001-01-01") {
+		endTime = ""
+	}
+	if err := d.Set(consts.FieldEndTime, endTime); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := resp.Data[consts.FieldMessage].(string); ok {
+		message, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return diag.Errorf("error decoding UI custom message %q: %s", path, err)
+		}
+		if err := d.Set(consts.FieldMessage, string(message)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var link []map[string]interface{}
+	if v, ok := resp.Data[consts.FieldLink].(map[string]interface{}); ok {
+		for title, href := range v {
+			link = append(link, map[string]interface{}{
+				consts.FieldTitle: title,
+				consts.FieldHref:  href,
+			})
+		}
+	}
+	if err := d.Set(consts.FieldLink, link); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func uiCustomMessageDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiCustomMessagePath(d.Id())
+
+	log.Printf("[DEBUG] Deleting UI custom message %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting UI custom message %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted UI custom message %q", path)
+
+	return nil
+}
+
+func uiCustomMessageRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		consts.FieldTitle:         d.Get(consts.FieldTitle),
+		consts.FieldMessage:       base64.StdEncoding.EncodeToString([]byte(d.Get(consts.FieldMessage).(string))),
+		consts.FieldAuthenticated: d.Get(consts.FieldAuthenticated),
+		consts.FieldType:          d.Get(consts.FieldType),
+		consts.FieldStartTime:     d.Get(consts.FieldStartTime),
+		consts.FieldOptions:       d.Get(consts.FieldOptions),
+	}
+
+	if v, ok := d.GetOk(consts.FieldEndTime); ok {
+		data[consts.FieldEndTime] = v
+	}
+
+	link := map[string]interface{}{}
+	if v, ok := d.GetOk(consts.FieldLink); ok {
+		for _, l := range v.([]interface{}) {
+			m := l.(map[string]interface{})
+			link[m[consts.FieldTitle].(string)] = m[consts.FieldHref]
+		}
+	}
+	data[consts.FieldLink] = link
+
+	return data
+}
+
+func uiCustomMessagePath(id string) string {
+	return fmt.Sprintf("%s/%s", uiCustomMessagesPath, id)
+}
@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccUICustomMessage(t *testing.T) {
+	title := acctest.RandomWithPrefix("tf-test")
+	resourceName := "vault_ui_custom_message.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.16") {
+				t.Skip("UI custom messages require Vault 1.16 or later")
+			}
+		},
+		CheckDestroy: testAccUICustomMessageCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUICustomMessageConfig(title, "Authorized use only", "banner", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTitle, title),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMessage, "Authorized use only"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, "banner"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAuthenticated, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldStartTime, "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.title", "Acceptable use policy"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.href", "https://example.com/aup"),
+				),
+			},
+			{
+				Config: testAccUICustomMessageConfig(title, "All activity is monitored", "modal", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTitle, title),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMessage, "All activity is monitored"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, "modal"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAuthenticated, "false"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccUICustomMessageCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ui_custom_message" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		resp, err := client.Logical().Read(uiCustomMessagePath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+
+		if resp != nil {
+			return fmt.Errorf("UI custom message %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccUICustomMessageConfig(title, message, messageType string, authenticated bool) string {
+	return fmt.Sprintf(`
+resource "vault_ui_custom_message" "test" {
+  title         = "%s"
+  message       = "%s"
+  type          = "%s"
+  authenticated = %t
+  start_time    = "2024-01-01T00:00:00Z"
+
+  link {
+    title = "Acceptable use policy"
+    href  = "https://example.com/aup"
+  }
+}
+`, title, message, messageType, authenticated)
+}
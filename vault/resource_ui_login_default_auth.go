@@ -0,0 +1,147 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const uiLoginDefaultAuthPath = "sys/config/ui/login/default-auth"
+
+var uiLoginDefaultAuthFields = []string{
+	consts.FieldNamespacePath,
+	consts.FieldDefaultAuthType,
+	consts.FieldBackupAuthTypes,
+	consts.FieldDisableInheritance,
+}
+
+func uiLoginDefaultAuthResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: MountCreateContextWrapper(uiLoginDefaultAuthWrite, provider.VaultVersion120),
+		ReadContext:   ReadContextWrapper(uiLoginDefaultAuthRead),
+		UpdateContext: uiLoginDefaultAuthWrite,
+		DeleteContext: uiLoginDefaultAuthDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the UI login default auth rule.",
+			},
+			consts.FieldNamespacePath: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The namespace the rule applies to. " +
+					"If not set, the rule applies to the root namespace.",
+				ValidateFunc: provider.ValidateNoLeadingTrailingSlashes,
+			},
+			consts.FieldDefaultAuthType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The auth method type that is preselected on the UI login page.",
+			},
+			consts.FieldBackupAuthTypes: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ordered list of auth method types offered as alternatives on the UI login page.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldDisableInheritance: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "If true, child namespaces do not inherit the login settings " +
+					"defined by this rule.",
+			},
+		},
+	}
+}
+
+func uiLoginDefaultAuthWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	name := d.Get(consts.FieldName).(string)
+	path := uiLoginDefaultAuthRulePath(name)
+
+	data := map[string]interface{}{}
+	for _, k := range uiLoginDefaultAuthFields {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing UI login default auth rule %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing UI login default auth rule %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote UI login default auth rule %q", path)
+
+	d.SetId(name)
+
+	return uiLoginDefaultAuthRead(ctx, d, meta)
+}
+
+func uiLoginDefaultAuthRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiLoginDefaultAuthRulePath(d.Id())
+
+	log.Printf("[DEBUG] Reading UI login default auth rule %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading UI login default auth rule %q: %s", path, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] UI login default auth rule %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldName, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range uiLoginDefaultAuthFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on UI login default auth rule, err=%s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func uiLoginDefaultAuthDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiLoginDefaultAuthRulePath(d.Id())
+
+	log.Printf("[DEBUG] Deleting UI login default auth rule %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting UI login default auth rule %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted UI login default auth rule %q", path)
+
+	return nil
+}
+
+func uiLoginDefaultAuthRulePath(name string) string {
+	return uiLoginDefaultAuthPath + "/" + name
+}
@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccUILoginDefaultAuth(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+	ns := "ns-" + name
+	resourceName := "vault_ui_login_default_auth.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			if !testutil.CheckTestVaultVersion(t, "1.20") {
+				t.Skip("UI login default auth rules require Vault 1.20 or later")
+			}
+		},
+		CheckDestroy: testAccUILoginDefaultAuthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUILoginDefaultAuthConfig(ns, name, "oidc", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldNamespacePath, ns),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultAuthType, "oidc"),
+					resource.TestCheckResourceAttr(resourceName, "backup_auth_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "backup_auth_types.0", "userpass"),
+					resource.TestCheckResourceAttr(resourceName, "backup_auth_types.1", "token"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDisableInheritance, "false"),
+				),
+			},
+			{
+				Config: testAccUILoginDefaultAuthConfig(ns, name, "ldap", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDefaultAuthType, "ldap"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDisableInheritance, "true"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccUILoginDefaultAuthCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ui_login_default_auth" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		resp, err := client.Logical().Read(uiLoginDefaultAuthRulePath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+
+		if resp != nil {
+			return fmt.Errorf("UI login default auth rule %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccUILoginDefaultAuthConfig(ns, name, defaultAuthType string, disableInheritance bool) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = "%s"
+}
+
+resource "vault_ui_login_default_auth" "test" {
+  name                = "%s"
+  namespace_path      = vault_namespace.test.path
+  default_auth_type   = "%s"
+  backup_auth_types   = ["userpass", "token"]
+  disable_inheritance = %t
+}
+`, ns, name, defaultAuthType, disableInheritance)
+}